@@ -0,0 +1,79 @@
+package graph
+
+import (
+	postgresdriver "github.com/pokt-foundation/pocket-indexer-lib/postgres-driver"
+	"github.com/pokt-foundation/pocket-indexer-services/api/graph/generated"
+	"github.com/pokt-foundation/pocket-indexer-services/api/graph/model"
+)
+
+// nestedListReadComplexity is the cost of the page read a nested list runs for each row of its parent,
+// counted on top of its rows so the parent page size multiplies the reads as well
+const nestedListReadComplexity = 100
+
+// NewComplexity returns the complexity model of the list fields, their fields cost once per row of their pages
+// so the fields nested in a list multiply its page size, the other fields cost 1 plus their fields
+func (r *Resolver) NewComplexity() generated.ComplexityRoot {
+	var complexity generated.ComplexityRoot
+
+	complexity.Query.QueryBlocks = func(childComplexity int, page *int, perPage *int, order *postgresdriver.Order,
+		first *int, after *string, last *int, before *string) int {
+		return r.getListComplexity(childComplexity, perPage, first, last)
+	}
+	complexity.Query.QueryTransactions = func(childComplexity int, page *int, perPage *int, order *postgresdriver.Order,
+		filter *model.TransactionFilter, first *int, after *string, last *int, before *string) int {
+		return r.getListComplexity(childComplexity, perPage, first, last)
+	}
+	complexity.Query.QueryTransactionsByAddress = func(childComplexity int, address string, page *int, perPage *int,
+		order *postgresdriver.Order, filter *model.TransactionFilter, first *int, after *string, last *int, before *string) int {
+		return r.getListComplexity(childComplexity, perPage, first, last)
+	}
+	complexity.Query.QueryTransactionsByHeight = func(childComplexity int, height int, page *int, perPage *int,
+		first *int, after *string, last *int, before *string) int {
+		return r.getListComplexity(childComplexity, perPage, first, last)
+	}
+	complexity.Query.QueryAccounts = r.getHeightListComplexity
+	complexity.Query.QueryNodes = r.getHeightListComplexity
+	complexity.Query.QueryApps = r.getHeightListComplexity
+
+	complexity.Block.Transactions = func(childComplexity int, page *int, perPage *int, filter *model.TransactionFilter,
+		first *int, after *string, last *int, before *string) int {
+		return r.getNestedListComplexity(childComplexity, perPage, first, last)
+	}
+	complexity.GraphQLNode.Transactions = r.getAddressTransactionsComplexity
+	complexity.GraphQLApp.Transactions = r.getAddressTransactionsComplexity
+
+	return complexity
+}
+
+func (r *Resolver) getHeightListComplexity(childComplexity int, height *int, page *int, perPage *int,
+	first *int, after *string, last *int, before *string) int {
+	return r.getListComplexity(childComplexity, perPage, first, last)
+}
+
+func (r *Resolver) getAddressTransactionsComplexity(childComplexity int, page *int, perPage *int, order *postgresdriver.Order,
+	filter *model.TransactionFilter, first *int, after *string, last *int, before *string) int {
+	return r.getNestedListComplexity(childComplexity, perPage, first, last)
+}
+
+// getNestedListComplexity returns the complexity of a list resolved with a read of its own per row of its parent
+func (r *Resolver) getNestedListComplexity(childComplexity int, sizes ...*int) int {
+	return nestedListReadComplexity + r.getListComplexity(childComplexity, sizes...)
+}
+
+// getListComplexity returns the complexity of a list of the page size set, the default one when none is,
+// the sizes above the max page size are counted as it since their operations are rejected by the page size limit
+func (r *Resolver) getListComplexity(childComplexity int, sizes ...*int) int {
+	pageSize := r.getDefaultPerPage()
+
+	for _, size := range sizes {
+		if size != nil && *size > 0 {
+			pageSize = *size
+		}
+	}
+
+	if r.MaxPageSize > 0 && pageSize > r.MaxPageSize {
+		pageSize = r.MaxPageSize
+	}
+
+	return 1 + childComplexity*pageSize
+}
@@ -0,0 +1,53 @@
+package graph
+
+import (
+	"testing"
+
+	"github.com/99designs/gqlgen/complexity"
+	"github.com/pokt-foundation/pocket-indexer-services/api/graph/generated"
+	"github.com/stretchr/testify/require"
+	"github.com/vektah/gqlparser/v2"
+)
+
+func TestResolver_NewComplexity(t *testing.T) {
+	r := &Resolver{MaxPageSize: 100}
+	schema := generated.NewExecutableSchema(generated.Config{Complexity: r.NewComplexity()})
+
+	tests := []struct {
+		name       string
+		query      string
+		complexity int
+	}{
+		{
+			name:       "list",
+			query:      "query { queryTransactions(perPage: 10) { transactions { hash } } }",
+			complexity: 1 + 10*(1+1),
+		},
+		{
+			name:       "page size over the max",
+			query:      "query { queryTransactions(first: 500) { transactions { hash } } }",
+			complexity: 1 + 100*(1+1),
+		},
+		{
+			name:       "nested list read per block",
+			query:      "query { queryBlocks(perPage: 10) { blocks { transactions(perPage: 5) { transactions { hash } } } } }",
+			complexity: 1 + 10*(1+nestedListReadComplexity+1+5*(1+1)),
+		},
+		{
+			name:       "nested list read per node",
+			query:      "query { queryNodes(perPage: 10) { nodes { transactions(first: 5) { transactions { hash } } } } }",
+			complexity: 1 + 10*(1+nestedListReadComplexity+1+5*(1+1)),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			c := require.New(t)
+
+			query, errs := gqlparser.LoadQuery(schema.Schema(), tt.query)
+			c.Empty(errs)
+
+			c.Equal(tt.complexity, complexity.Calculate(schema, query.Operations[0], nil))
+		})
+	}
+}
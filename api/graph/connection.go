@@ -0,0 +1,328 @@
+package graph
+
+import (
+	"context"
+	"encoding/base64"
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/99designs/gqlgen/graphql"
+	indexerlib "github.com/pokt-foundation/pocket-indexer-lib"
+	postgresdriver "github.com/pokt-foundation/pocket-indexer-lib/postgres-driver"
+	"github.com/pokt-foundation/pocket-indexer-services/api/graph/model"
+	"github.com/pokt-foundation/pocket-indexer-services/storage"
+)
+
+// cursor kinds, a cursor of a list can't be used to paginate another
+const (
+	blockCursor       = "block"
+	transactionCursor = "transaction"
+	accountCursor     = "account"
+	nodeCursor        = "node"
+	appCursor         = "app"
+)
+
+var (
+	errInvalidCursor     = errors.New("invalid cursor")
+	errFirstAndLast      = errors.New("first and last can't be combined")
+	errInvalidPageLength = errors.New("first and last must be greater than 0")
+	errPageAndCursor     = errors.New("page and perPage can't be combined with first, after, last or before")
+	errInvalidPage       = errors.New("page and perPage must be greater than 0")
+)
+
+// encodeCursor returns the opaque cursor of a row of the list of given kind
+func encodeCursor(kind string, cursor *storage.Cursor) string {
+	key := fmt.Sprintf("%s:%d:%d:%s:%s", kind, cursor.Height, cursor.Index, cursor.Address, cursor.AccountType)
+
+	return base64.RawURLEncoding.EncodeToString([]byte(key))
+}
+
+// decodeCursor returns the row position of a cursor of the list of given kind, nil when there is no cursor
+func decodeCursor(kind string, value *string) (*storage.Cursor, error) {
+	if value == nil {
+		return nil, nil
+	}
+
+	key, err := base64.RawURLEncoding.DecodeString(*value)
+	if err != nil {
+		return nil, errInvalidCursor
+	}
+
+	parts := strings.SplitN(string(key), ":", 5)
+	if len(parts) != 5 || parts[0] != kind {
+		return nil, errInvalidCursor
+	}
+
+	height, err := strconv.Atoi(parts[1])
+	if err != nil {
+		return nil, errInvalidCursor
+	}
+
+	index, err := strconv.Atoi(parts[2])
+	if err != nil {
+		return nil, errInvalidCursor
+	}
+
+	return &storage.Cursor{Height: height, Index: index, Address: parts[3], AccountType: parts[4]}, nil
+}
+
+// connectionArgs are the pagination arguments of a list query, the Relay connection arguments
+// can't be combined with the page offsets, countSelected is whether the list asks for its rows counted
+type connectionArgs struct {
+	page          *int
+	perPage       *int
+	first         *int
+	after         *string
+	last          *int
+	before        *string
+	countSelected bool
+}
+
+// newConnectionArgs returns the pagination arguments of the list resolved in ctx
+func newConnectionArgs(ctx context.Context, page, perPage, first *int, after *string, last *int, before *string) *connectionArgs {
+	return &connectionArgs{
+		page:          page,
+		perPage:       perPage,
+		first:         first,
+		after:         after,
+		last:          last,
+		before:        before,
+		countSelected: isCountSelected(ctx),
+	}
+}
+
+// isCountSelected reports whether the list resolved in ctx selects totalCount or totalPages,
+// the only fields of a page that need its rows counted
+func isCountSelected(ctx context.Context) bool {
+	if !graphql.HasOperationContext(ctx) {
+		return true
+	}
+
+	for _, field := range graphql.CollectFieldsCtx(ctx, nil) {
+		if field.Name == "totalCount" || field.Name == "totalPages" {
+			return true
+		}
+	}
+
+	return false
+}
+
+// count returns the quantity of rows of a page when it is selected, 0 otherwise
+// so scrolling through the pages or resolving a nested list never counts the whole list
+func (a *connectionArgs) count(count func() (int64, error)) (int, error) {
+	if !a.countSelected {
+		return 0, nil
+	}
+
+	quantity, err := count()
+
+	return int(quantity), err
+}
+
+// isCursorPage reports whether the list is paginated by cursors instead of page offsets
+func (a *connectionArgs) isCursorPage() bool {
+	return a.first != nil || a.after != nil || a.last != nil || a.before != nil
+}
+
+// getPageOptions returns the keyset page of the arguments, the first perPage rows
+// when neither first nor last is set
+func (a *connectionArgs) getPageOptions(kind string, order postgresdriver.Order, perPage int) (*storage.PageOptions, error) {
+	if a.page != nil || a.perPage != nil {
+		return nil, errPageAndCursor
+	}
+
+	if a.first != nil && a.last != nil {
+		return nil, errFirstAndLast
+	}
+
+	options := &storage.PageOptions{
+		Limit:      perPage,
+		Backward:   a.last != nil,
+		Descending: order == postgresdriver.DescendantOrder,
+	}
+
+	for _, limit := range []*int{a.first, a.last} {
+		if limit != nil {
+			options.Limit = *limit
+		}
+	}
+
+	if options.Limit <= 0 {
+		return nil, errInvalidPageLength
+	}
+
+	return a.setCursors(kind, options)
+}
+
+// getOffsetPage returns the page and page length of the arguments, the first page of perPage rows
+// when they are not set
+func (a *connectionArgs) getOffsetPage(perPage int) (int, int, error) {
+	page := defaultPage
+
+	if a.page != nil {
+		page = *a.page
+	}
+	if a.perPage != nil {
+		perPage = *a.perPage
+	}
+
+	if page <= 0 || perPage <= 0 {
+		return 0, 0, errInvalidPage
+	}
+
+	return page, perPage, nil
+}
+
+func (a *connectionArgs) setCursors(kind string, options *storage.PageOptions) (*storage.PageOptions, error) {
+	var err error
+
+	options.After, err = decodeCursor(kind, a.after)
+	if err != nil {
+		return nil, err
+	}
+
+	options.Before, err = decodeCursor(kind, a.before)
+	if err != nil {
+		return nil, err
+	}
+
+	return options, nil
+}
+
+// getPageInfo returns the page info of a cursor page, hasMore being whether there are rows past it
+// in the direction it was read, the rows on the side of the cursor it started from are assumed
+func (a *connectionArgs) getPageInfo(hasMore bool, cursors []string) *model.PageInfo {
+	pageInfo := &model.PageInfo{
+		HasNextPage:     hasMore,
+		HasPreviousPage: a.after != nil,
+	}
+
+	if a.last != nil {
+		pageInfo.HasNextPage = a.before != nil
+		pageInfo.HasPreviousPage = hasMore
+	}
+
+	setPageCursors(pageInfo, cursors)
+
+	return pageInfo
+}
+
+// getOffsetPageInfo returns the page info of a page offset, so a list can move on to cursors from any page
+func getOffsetPageInfo(page int, hasNextPage bool, cursors []string) *model.PageInfo {
+	pageInfo := &model.PageInfo{
+		HasNextPage:     hasNextPage,
+		HasPreviousPage: page > 1,
+	}
+
+	setPageCursors(pageInfo, cursors)
+
+	return pageInfo
+}
+
+func setPageCursors(pageInfo *model.PageInfo, cursors []string) {
+	if len(cursors) == 0 {
+		return
+	}
+
+	pageInfo.StartCursor = &cursors[0]
+	pageInfo.EndCursor = &cursors[len(cursors)-1]
+}
+
+// getListHeight returns the height of a list of accounts, nodes or apps, the height of the cursor when there is one
+// so the next pages don't move to a newer height, 0 for the max height saved
+func getListHeight(height *int, options *storage.PageOptions) int {
+	switch {
+	case options.After != nil:
+		return options.After.Height
+	case options.Before != nil:
+		return options.Before.Height
+	case height != nil:
+		return *height
+	default:
+		return 0
+	}
+}
+
+func getOrder(order *postgresdriver.Order, defaultListOrder postgresdriver.Order) postgresdriver.Order {
+	if order == nil {
+		return defaultListOrder
+	}
+
+	return *order
+}
+
+func getBlockEdges(blocks []*indexerlib.Block) ([]*model.BlockEdge, []string) {
+	edges := make([]*model.BlockEdge, 0, len(blocks))
+	cursors := make([]string, 0, len(blocks))
+
+	for _, block := range blocks {
+		cursor := encodeCursor(blockCursor, &storage.Cursor{Height: block.Height})
+
+		edges = append(edges, &model.BlockEdge{Cursor: cursor, Node: block})
+		cursors = append(cursors, cursor)
+	}
+
+	return edges, cursors
+}
+
+func getTransactionEdges(transactions []*model.GraphQLTransaction) ([]*model.TransactionEdge, []string) {
+	edges := make([]*model.TransactionEdge, 0, len(transactions))
+	cursors := make([]string, 0, len(transactions))
+
+	for _, transaction := range transactions {
+		cursor := encodeCursor(transactionCursor, &storage.Cursor{Height: transaction.Height, Index: transaction.Index})
+
+		edges = append(edges, &model.TransactionEdge{Cursor: cursor, Node: transaction})
+		cursors = append(cursors, cursor)
+	}
+
+	return edges, cursors
+}
+
+func getAccountEdges(accounts []*model.GraphQLAccount) ([]*model.AccountEdge, []string) {
+	edges := make([]*model.AccountEdge, 0, len(accounts))
+	cursors := make([]string, 0, len(accounts))
+
+	for _, account := range accounts {
+		cursor := encodeCursor(accountCursor, &storage.Cursor{
+			Height:      account.Height,
+			Address:     account.Address,
+			AccountType: account.AccountType,
+		})
+
+		edges = append(edges, &model.AccountEdge{Cursor: cursor, Node: account})
+		cursors = append(cursors, cursor)
+	}
+
+	return edges, cursors
+}
+
+func getNodeEdges(nodes []*model.GraphQLNode) ([]*model.NodeEdge, []string) {
+	edges := make([]*model.NodeEdge, 0, len(nodes))
+	cursors := make([]string, 0, len(nodes))
+
+	for _, node := range nodes {
+		cursor := encodeCursor(nodeCursor, &storage.Cursor{Height: node.Height, Address: node.Address})
+
+		edges = append(edges, &model.NodeEdge{Cursor: cursor, Node: node})
+		cursors = append(cursors, cursor)
+	}
+
+	return edges, cursors
+}
+
+func getAppEdges(apps []*model.GraphQLApp) ([]*model.AppEdge, []string) {
+	edges := make([]*model.AppEdge, 0, len(apps))
+	cursors := make([]string, 0, len(apps))
+
+	for _, app := range apps {
+		cursor := encodeCursor(appCursor, &storage.Cursor{Height: app.Height, Address: app.Address})
+
+		edges = append(edges, &model.AppEdge{Cursor: cursor, Node: app})
+		cursors = append(cursors, cursor)
+	}
+
+	return edges, cursors
+}
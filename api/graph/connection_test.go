@@ -0,0 +1,203 @@
+package graph
+
+import (
+	"context"
+	"encoding/base64"
+	"errors"
+	"testing"
+
+	postgresdriver "github.com/pokt-foundation/pocket-indexer-lib/postgres-driver"
+	"github.com/pokt-foundation/pocket-indexer-services/storage"
+	"github.com/stretchr/testify/require"
+)
+
+func TestEncodeCursor(t *testing.T) {
+	tests := []struct {
+		name   string
+		kind   string
+		cursor *storage.Cursor
+	}{
+		{name: "block", kind: blockCursor, cursor: &storage.Cursor{Height: 21}},
+		{name: "transaction", kind: transactionCursor, cursor: &storage.Cursor{Height: 21, Index: 3}},
+		{name: "node", kind: nodeCursor, cursor: &storage.Cursor{Height: 21, Address: "00353abd21ef72725b295ba5a9a5eb6082548e21"}},
+		{
+			name:   "account keyed by address and type",
+			kind:   accountCursor,
+			cursor: &storage.Cursor{Height: 21, Address: "00353abd21ef72725b295ba5a9a5eb6082548e21", AccountType: "node"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			c := require.New(t)
+
+			value := encodeCursor(tt.kind, tt.cursor)
+
+			cursor, err := decodeCursor(tt.kind, &value)
+			c.NoError(err)
+			c.Equal(tt.cursor, cursor)
+		})
+	}
+}
+
+func TestDecodeCursor(t *testing.T) {
+	encode := func(key string) *string {
+		value := base64.RawURLEncoding.EncodeToString([]byte(key))
+		return &value
+	}
+
+	notBase64 := "not a cursor!"
+
+	tests := []struct {
+		name   string
+		value  *string
+		cursor *storage.Cursor
+		err    error
+	}{
+		{name: "no cursor"},
+		{name: "valid", value: encode("account:5:0:a1:app"), cursor: &storage.Cursor{Height: 5, Address: "a1", AccountType: "app"}},
+		{name: "not base64", value: &notBase64, err: errInvalidCursor},
+		{name: "cursor of another list", value: encode("node:5:0:a1:"), err: errInvalidCursor},
+		{name: "cursor before account types", value: encode("account:5:0:a1"), err: errInvalidCursor},
+		{name: "invalid height", value: encode("account:five:0:a1:app"), err: errInvalidCursor},
+		{name: "invalid index", value: encode("account:5:zero:a1:app"), err: errInvalidCursor},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			c := require.New(t)
+
+			cursor, err := decodeCursor(accountCursor, tt.value)
+
+			c.Equal(tt.err, err)
+			c.Equal(tt.cursor, cursor)
+		})
+	}
+}
+
+func TestConnectionArgs_GetPageOptions(t *testing.T) {
+	one, two := 1, 2
+	zero := 0
+	after := encodeCursor(blockCursor, &storage.Cursor{Height: 10})
+	before := encodeCursor(blockCursor, &storage.Cursor{Height: 20})
+	otherKind := encodeCursor(nodeCursor, &storage.Cursor{Height: 10})
+
+	tests := []struct {
+		name    string
+		args    *connectionArgs
+		order   postgresdriver.Order
+		options *storage.PageOptions
+		err     error
+	}{
+		{
+			name:    "default page length",
+			args:    &connectionArgs{},
+			order:   postgresdriver.AscendantOrder,
+			options: &storage.PageOptions{Limit: 10},
+		},
+		{
+			name:    "first after a cursor",
+			args:    &connectionArgs{first: &two, after: &after},
+			order:   postgresdriver.DescendantOrder,
+			options: &storage.PageOptions{Limit: 2, After: &storage.Cursor{Height: 10}, Descending: true},
+		},
+		{
+			name:    "last before a cursor",
+			args:    &connectionArgs{last: &one, before: &before},
+			order:   postgresdriver.AscendantOrder,
+			options: &storage.PageOptions{Limit: 1, Before: &storage.Cursor{Height: 20}, Backward: true},
+		},
+		{name: "page offsets and cursors", args: &connectionArgs{page: &one, first: &one}, err: errPageAndCursor},
+		{name: "first and last", args: &connectionArgs{first: &one, last: &one}, err: errFirstAndLast},
+		{name: "zero page length", args: &connectionArgs{first: &zero}, err: errInvalidPageLength},
+		{name: "cursor of another list", args: &connectionArgs{after: &otherKind}, err: errInvalidCursor},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			c := require.New(t)
+
+			options, err := tt.args.getPageOptions(blockCursor, tt.order, 10)
+
+			c.Equal(tt.err, err)
+			c.Equal(tt.options, options)
+		})
+	}
+}
+
+func TestConnectionArgs_GetOffsetPage(t *testing.T) {
+	three, five := 3, 5
+	zero, negative := 0, -1
+
+	tests := []struct {
+		name    string
+		args    *connectionArgs
+		page    int
+		perPage int
+		err     error
+	}{
+		{name: "default page", args: &connectionArgs{}, page: 1, perPage: 10},
+		{name: "page and page length", args: &connectionArgs{page: &three, perPage: &five}, page: 3, perPage: 5},
+		{name: "zero page", args: &connectionArgs{page: &zero}, err: errInvalidPage},
+		{name: "negative page", args: &connectionArgs{page: &negative}, err: errInvalidPage},
+		{name: "zero page length", args: &connectionArgs{perPage: &zero}, err: errInvalidPage},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			c := require.New(t)
+
+			page, perPage, err := tt.args.getOffsetPage(10)
+
+			c.Equal(tt.err, err)
+			c.Equal(tt.page, page)
+			c.Equal(tt.perPage, perPage)
+		})
+	}
+}
+
+func TestConnectionArgs_Count(t *testing.T) {
+	c := require.New(t)
+
+	counted := false
+	count := func() (int64, error) {
+		counted = true
+		return 42, nil
+	}
+
+	quantity, err := (&connectionArgs{}).count(count)
+	c.NoError(err)
+	c.Zero(quantity)
+	c.False(counted)
+
+	quantity, err = (&connectionArgs{countSelected: true}).count(count)
+	c.NoError(err)
+	c.Equal(42, quantity)
+	c.True(counted)
+
+	_, err = (&connectionArgs{countSelected: true}).count(func() (int64, error) {
+		return 0, errors.New("dummy error")
+	})
+	c.EqualError(err, "dummy error")
+
+	// Outside of a GraphQL operation there is no selection to check so the rows are counted
+	c.True(newConnectionArgs(context.Background(), nil, nil, nil, nil, nil, nil).countSelected)
+}
+
+func TestConnectionArgs_GetPageInfo(t *testing.T) {
+	c := require.New(t)
+
+	one := 1
+	cursor := "cursor"
+
+	forward := (&connectionArgs{first: &one, after: &cursor}).getPageInfo(true, []string{"a", "b"})
+	c.True(forward.HasNextPage)
+	c.True(forward.HasPreviousPage)
+	c.Equal("a", *forward.StartCursor)
+	c.Equal("b", *forward.EndCursor)
+
+	backward := (&connectionArgs{last: &one}).getPageInfo(true, nil)
+	c.False(backward.HasNextPage)
+	c.True(backward.HasPreviousPage)
+	c.Nil(backward.StartCursor)
+}
@@ -0,0 +1,213 @@
+package graph
+
+import (
+	postgresdriver "github.com/pokt-foundation/pocket-indexer-lib/postgres-driver"
+	"github.com/pokt-foundation/pocket-indexer-services/api/graph/model"
+	"github.com/pokt-foundation/pocket-indexer-services/storage"
+)
+
+func (r *Resolver) readBlocksCursorPage(args *connectionArgs, order postgresdriver.Order) (*model.BlocksResponse, error) {
+	options, err := args.getPageOptions(blockCursor, order, r.getDefaultPerPage())
+	if err != nil {
+		return nil, err
+	}
+
+	blocks, hasMore, err := r.Reader.ReadBlocksPage(options)
+	if err != nil {
+		return nil, err
+	}
+
+	quantity, err := args.count(r.Reader.GetBlocksQuantity)
+	if err != nil {
+		return nil, err
+	}
+
+	edges, cursors := getBlockEdges(blocks)
+
+	return &model.BlocksResponse{
+		Blocks:     blocks,
+		TotalCount: quantity,
+		PageCount:  len(blocks),
+		TotalPages: getTotalPages(quantity, options.Limit),
+		Edges:      edges,
+		PageInfo:   args.getPageInfo(hasMore, cursors),
+	}, nil
+}
+
+// readTransactions returns the page of the transactions kept by the filter, read by page offset or by cursors
+func (r *Resolver) readTransactions(args *connectionArgs, order postgresdriver.Order,
+	filter *storage.TransactionFilter) (*model.TransactionsResponse, error) {
+	if args.isCursorPage() {
+		return r.readTransactionsCursorPage(args, order, filter)
+	}
+
+	return r.readTransactionsOffsetPage(args, order, filter)
+}
+
+func (r *Resolver) readTransactionsOffsetPage(args *connectionArgs, order postgresdriver.Order,
+	filter *storage.TransactionFilter) (*model.TransactionsResponse, error) {
+	page, perPage, err := args.getOffsetPage(r.getDefaultPerPage())
+	if err != nil {
+		return nil, err
+	}
+
+	transactions, hasMore, err := r.Reader.ReadTransactionsPage(filter, &storage.PageOptions{
+		Limit:      perPage,
+		Offset:     (page - 1) * perPage,
+		Descending: order == postgresdriver.DescendantOrder,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	quantity, err := args.count(func() (int64, error) {
+		return r.Reader.CountTransactions(filter)
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	graphqlTransactions := convertMultipleIndexerTransactionsToGrapQLTransactions(transactions)
+	edges, cursors := getTransactionEdges(graphqlTransactions)
+
+	return &model.TransactionsResponse{
+		Transactions: graphqlTransactions,
+		Page:         page,
+		TotalCount:   quantity,
+		PageCount:    len(transactions),
+		TotalPages:   getTotalPages(quantity, perPage),
+		Edges:        edges,
+		PageInfo:     getOffsetPageInfo(page, hasMore, cursors),
+	}, nil
+}
+
+func (r *Resolver) readTransactionsCursorPage(args *connectionArgs, order postgresdriver.Order,
+	filter *storage.TransactionFilter) (*model.TransactionsResponse, error) {
+	options, err := args.getPageOptions(transactionCursor, order, r.getDefaultPerPage())
+	if err != nil {
+		return nil, err
+	}
+
+	transactions, hasMore, err := r.Reader.ReadTransactionsPage(filter, options)
+	if err != nil {
+		return nil, err
+	}
+
+	quantity, err := args.count(func() (int64, error) {
+		return r.Reader.CountTransactions(filter)
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	graphqlTransactions := convertMultipleIndexerTransactionsToGrapQLTransactions(transactions)
+	edges, cursors := getTransactionEdges(graphqlTransactions)
+
+	return &model.TransactionsResponse{
+		Transactions: graphqlTransactions,
+		TotalCount:   quantity,
+		PageCount:    len(transactions),
+		TotalPages:   getTotalPages(quantity, options.Limit),
+		Edges:        edges,
+		PageInfo:     args.getPageInfo(hasMore, cursors),
+	}, nil
+}
+
+func (r *Resolver) readAccountsCursorPage(args *connectionArgs, height *int) (*model.AccountsResponse, error) {
+	options, err := args.getPageOptions(accountCursor, postgresdriver.AscendantOrder, r.getDefaultPerPage())
+	if err != nil {
+		return nil, err
+	}
+
+	listHeight := getListHeight(height, options)
+
+	accounts, hasMore, err := r.Reader.ReadAccountsPage(listHeight, options)
+	if err != nil {
+		return nil, err
+	}
+
+	quantity, err := args.count(func() (int64, error) {
+		return r.Reader.GetAccountsQuantity(&postgresdriver.GetAccountsQuantityOptions{Height: listHeight})
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	graphqlAccounts := convertMultipleIndexerAccountToGraphQLAccount(accounts)
+	edges, cursors := getAccountEdges(graphqlAccounts)
+
+	return &model.AccountsResponse{
+		Accounts:   graphqlAccounts,
+		TotalCount: quantity,
+		PageCount:  len(accounts),
+		TotalPages: getTotalPages(quantity, options.Limit),
+		Edges:      edges,
+		PageInfo:   args.getPageInfo(hasMore, cursors),
+	}, nil
+}
+
+func (r *Resolver) readNodesCursorPage(args *connectionArgs, height *int) (*model.NodesResponse, error) {
+	options, err := args.getPageOptions(nodeCursor, postgresdriver.AscendantOrder, r.getDefaultPerPage())
+	if err != nil {
+		return nil, err
+	}
+
+	listHeight := getListHeight(height, options)
+
+	nodes, hasMore, err := r.Reader.ReadNodesPage(listHeight, options)
+	if err != nil {
+		return nil, err
+	}
+
+	quantity, err := args.count(func() (int64, error) {
+		return r.Reader.GetNodesQuantity(&postgresdriver.GetNodesQuantityOptions{Height: listHeight})
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	graphqlNodes := convertMultipleIndexerNodeToGraphQLNode(nodes)
+	edges, cursors := getNodeEdges(graphqlNodes)
+
+	return &model.NodesResponse{
+		Nodes:      graphqlNodes,
+		TotalCount: quantity,
+		PageCount:  len(nodes),
+		TotalPages: getTotalPages(quantity, options.Limit),
+		Edges:      edges,
+		PageInfo:   args.getPageInfo(hasMore, cursors),
+	}, nil
+}
+
+func (r *Resolver) readAppsCursorPage(args *connectionArgs, height *int) (*model.AppsResponse, error) {
+	options, err := args.getPageOptions(appCursor, postgresdriver.AscendantOrder, r.getDefaultPerPage())
+	if err != nil {
+		return nil, err
+	}
+
+	listHeight := getListHeight(height, options)
+
+	apps, hasMore, err := r.Reader.ReadAppsPage(listHeight, options)
+	if err != nil {
+		return nil, err
+	}
+
+	quantity, err := args.count(func() (int64, error) {
+		return r.Reader.GetAppsQuantity(&postgresdriver.GetAppsQuantityOptions{Height: listHeight})
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	graphqlApps := convertMultipleIndexeraAppToGraphQLApp(apps)
+	edges, cursors := getAppEdges(graphqlApps)
+
+	return &model.AppsResponse{
+		Apps:       graphqlApps,
+		TotalCount: quantity,
+		PageCount:  len(apps),
+		TotalPages: getTotalPages(quantity, options.Limit),
+		Edges:      edges,
+		PageInfo:   args.getPageInfo(hasMore, cursors),
+	}, nil
+}
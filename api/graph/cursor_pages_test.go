@@ -0,0 +1,57 @@
+package graph
+
+import (
+	"testing"
+
+	indexerlib "github.com/pokt-foundation/pocket-indexer-lib"
+	postgresdriver "github.com/pokt-foundation/pocket-indexer-lib/postgres-driver"
+	"github.com/pokt-foundation/pocket-indexer-services/storage"
+	"github.com/stretchr/testify/require"
+)
+
+func (r *fakeReader) ReadTransactionsPage(filter *storage.TransactionFilter, options *storage.PageOptions) ([]*indexerlib.Transaction, bool, error) {
+	transactions := r.transactions[options.Offset:]
+	if len(transactions) > options.Limit {
+		return transactions[:options.Limit], true, nil
+	}
+
+	return transactions, false, nil
+}
+
+func (r *fakeReader) CountTransactions(filter *storage.TransactionFilter) (int64, error) {
+	r.counts++
+
+	return int64(len(r.transactions)), nil
+}
+
+func TestResolver_ReadTransactionsOffsetPage(t *testing.T) {
+	c := require.New(t)
+
+	fakeReader := &fakeReader{}
+	for i := 0; i < 5; i++ {
+		fakeReader.transactions = append(fakeReader.transactions, &indexerlib.Transaction{Height: 10, Index: i})
+	}
+
+	r := &Resolver{Reader: fakeReader}
+	page, perPage := 2, 2
+
+	response, err := r.readTransactionsOffsetPage(&connectionArgs{page: &page, perPage: &perPage},
+		postgresdriver.AscendantOrder, &storage.TransactionFilter{})
+	c.NoError(err)
+	c.Zero(fakeReader.counts)
+	c.Equal(2, response.PageCount)
+	c.Zero(response.TotalCount)
+	c.True(response.PageInfo.HasNextPage)
+	c.True(response.PageInfo.HasPreviousPage)
+
+	page = 3
+
+	response, err = r.readTransactionsOffsetPage(&connectionArgs{page: &page, perPage: &perPage, countSelected: true},
+		postgresdriver.AscendantOrder, &storage.TransactionFilter{})
+	c.NoError(err)
+	c.Equal(1, fakeReader.counts)
+	c.Equal(1, response.PageCount)
+	c.Equal(5, response.TotalCount)
+	c.Equal(3, response.TotalPages)
+	c.False(response.PageInfo.HasNextPage)
+}
@@ -0,0 +1,99 @@
+package graph
+
+import (
+	"errors"
+	"math/big"
+	"time"
+
+	"github.com/pokt-foundation/pocket-indexer-services/api/graph/model"
+	"github.com/pokt-foundation/pocket-indexer-services/storage"
+)
+
+var (
+	errInvalidAmount           = errors.New("minAmount and maxAmount must be integers")
+	errDirectionWithoutAddress = errors.New("direction only applies to the transactions of an address")
+)
+
+// getTransactionFilter returns the filter of the transactions of the address, of every address when it is empty
+func getTransactionFilter(input *model.TransactionFilter, address string) (*storage.TransactionFilter, error) {
+	filter := &storage.TransactionFilter{Address: address}
+
+	if input == nil {
+		return filter, nil
+	}
+
+	if input.Direction != nil && address == "" {
+		return nil, errDirectionWithoutAddress
+	}
+
+	for _, amount := range []*string{input.MinAmount, input.MaxAmount} {
+		if amount == nil {
+			continue
+		}
+
+		if _, ok := new(big.Int).SetString(*amount, 10); !ok {
+			return nil, errInvalidAmount
+		}
+	}
+
+	filter.Direction = getDirection(input.Direction)
+	filter.MessageType = getStringValue(input.MessageType)
+	filter.Blockchains = input.Blockchains
+	filter.FromHeight = getIntValue(input.FromHeight)
+	filter.ToHeight = getIntValue(input.ToHeight)
+	filter.FromTime = getTimeValue(input.FromTime)
+	filter.ToTime = getTimeValue(input.ToTime)
+	filter.Success = getSuccess(input.Result)
+	filter.MinFee = input.MinFee
+	filter.MaxFee = input.MaxFee
+	filter.MinAmount = getStringValue(input.MinAmount)
+	filter.MaxAmount = getStringValue(input.MaxAmount)
+
+	return filter, nil
+}
+
+func getDirection(direction *model.TransactionDirection) string {
+	if direction == nil {
+		return ""
+	}
+
+	if *direction == model.TransactionDirectionSent {
+		return storage.DirectionSent
+	}
+
+	return storage.DirectionReceived
+}
+
+func getSuccess(result *model.TransactionResult) *bool {
+	if result == nil {
+		return nil
+	}
+
+	success := *result == model.TransactionResultSuccess
+
+	return &success
+}
+
+func getStringValue(value *string) string {
+	if value == nil {
+		return ""
+	}
+
+	return *value
+}
+
+func getIntValue(value *int) int {
+	if value == nil {
+		return 0
+	}
+
+	return *value
+}
+
+func getTimeValue(value *time.Time) time.Time {
+	if value == nil {
+		return time.Time{}
+	}
+
+	return *value
+}
@@ -0,0 +1,96 @@
+package graph
+
+import (
+	"testing"
+	"time"
+
+	"github.com/pokt-foundation/pocket-indexer-services/api/graph/model"
+	"github.com/pokt-foundation/pocket-indexer-services/storage"
+	"github.com/stretchr/testify/require"
+)
+
+func TestGetTransactionFilter(t *testing.T) {
+	address := "00353abd21ef72725b295ba5a9a5eb6082548e21"
+	messageType := "send"
+	fromHeight, toHeight := 10, 20
+	fromTime := time.Date(2022, 6, 1, 0, 0, 0, 0, time.UTC)
+	minFee := 10000
+	minAmount, maxAmount := "1000000", "99999999999999999999"
+	invalidAmount := "1.5"
+	sent, received := model.TransactionDirectionSent, model.TransactionDirectionReceived
+	success, failure := model.TransactionResultSuccess, model.TransactionResultFailure
+	succeeded, failed := true, false
+
+	tests := []struct {
+		name     string
+		input    *model.TransactionFilter
+		address  string
+		expected *storage.TransactionFilter
+		err      error
+	}{
+		{name: "no filter", address: address, expected: &storage.TransactionFilter{Address: address}},
+		{name: "no filter nor address", expected: &storage.TransactionFilter{}},
+		{
+			name: "every field",
+			input: &model.TransactionFilter{
+				MessageType: &messageType,
+				Blockchains: []string{"0021", "0040"},
+				FromHeight:  &fromHeight,
+				ToHeight:    &toHeight,
+				FromTime:    &fromTime,
+				Direction:   &sent,
+				Result:      &success,
+				MinFee:      &minFee,
+				MinAmount:   &minAmount,
+				MaxAmount:   &maxAmount,
+			},
+			address: address,
+			expected: &storage.TransactionFilter{
+				Address:     address,
+				Direction:   storage.DirectionSent,
+				MessageType: messageType,
+				Blockchains: []string{"0021", "0040"},
+				FromHeight:  fromHeight,
+				ToHeight:    toHeight,
+				FromTime:    fromTime,
+				Success:     &succeeded,
+				MinFee:      &minFee,
+				MinAmount:   minAmount,
+				MaxAmount:   maxAmount,
+			},
+		},
+		{
+			name:     "received failed transactions",
+			input:    &model.TransactionFilter{Direction: &received, Result: &failure},
+			address:  address,
+			expected: &storage.TransactionFilter{Address: address, Direction: storage.DirectionReceived, Success: &failed},
+		},
+		{
+			name:     "empty filter",
+			input:    &model.TransactionFilter{},
+			expected: &storage.TransactionFilter{},
+		},
+		{
+			name:  "direction without address",
+			input: &model.TransactionFilter{Direction: &sent},
+			err:   errDirectionWithoutAddress,
+		},
+		{
+			name:    "decimal amount",
+			input:   &model.TransactionFilter{MinAmount: &minAmount, MaxAmount: &invalidAmount},
+			address: address,
+			err:     errInvalidAmount,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			c := require.New(t)
+
+			filter, err := getTransactionFilter(tt.input, tt.address)
+
+			c.Equal(tt.err, err)
+			c.Equal(tt.expected, filter)
+		})
+	}
+}
@@ -9,6 +9,7 @@ import (
 	"fmt"
 	"strconv"
 	"sync"
+	"sync/atomic"
 	"time"
 
 	"github.com/99designs/gqlgen/graphql"
@@ -39,6 +40,10 @@ type Config struct {
 }
 
 type ResolverRoot interface {
+	Block() BlockResolver
+	GraphQLApp() GraphQLAppResolver
+	GraphQLNode() GraphQLNodeResolver
+	GraphQLTransaction() GraphQLTransactionResolver
 	Query() QueryResolver
 }
 
@@ -46,18 +51,32 @@ type DirectiveRoot struct {
 }
 
 type ComplexityRoot struct {
+	AccountEdge struct {
+		Cursor func(childComplexity int) int
+		Node   func(childComplexity int) int
+	}
+
 	AccountsResponse struct {
 		Accounts   func(childComplexity int) int
+		Edges      func(childComplexity int) int
 		Page       func(childComplexity int) int
 		PageCount  func(childComplexity int) int
+		PageInfo   func(childComplexity int) int
 		TotalCount func(childComplexity int) int
 		TotalPages func(childComplexity int) int
 	}
 
+	AppEdge struct {
+		Cursor func(childComplexity int) int
+		Node   func(childComplexity int) int
+	}
+
 	AppsResponse struct {
 		Apps       func(childComplexity int) int
+		Edges      func(childComplexity int) int
 		Page       func(childComplexity int) int
 		PageCount  func(childComplexity int) int
+		PageInfo   func(childComplexity int) int
 		TotalCount func(childComplexity int) int
 		TotalPages func(childComplexity int) int
 	}
@@ -68,12 +87,20 @@ type ComplexityRoot struct {
 		ProposerAddress func(childComplexity int) int
 		TXCount         func(childComplexity int) int
 		Time            func(childComplexity int) int
+		Transactions    func(childComplexity int, page *int, perPage *int, filter *model.TransactionFilter, first *int, after *string, last *int, before *string) int
+	}
+
+	BlockEdge struct {
+		Cursor func(childComplexity int) int
+		Node   func(childComplexity int) int
 	}
 
 	BlocksResponse struct {
 		Blocks     func(childComplexity int) int
+		Edges      func(childComplexity int) int
 		Page       func(childComplexity int) int
 		PageCount  func(childComplexity int) int
+		PageInfo   func(childComplexity int) int
 		TotalCount func(childComplexity int) int
 		TotalPages func(childComplexity int) int
 	}
@@ -92,62 +119,83 @@ type ComplexityRoot struct {
 	}
 
 	GraphQLApp struct {
+		Account      func(childComplexity int) int
 		Address      func(childComplexity int) int
 		Height       func(childComplexity int) int
 		Jailed       func(childComplexity int) int
 		PublicKey    func(childComplexity int) int
 		StakedTokens func(childComplexity int) int
+		Transactions func(childComplexity int, page *int, perPage *int, order *postgresdriver.Order, filter *model.TransactionFilter, first *int, after *string, last *int, before *string) int
 	}
 
 	GraphQLNode struct {
-		Address    func(childComplexity int) int
-		Height     func(childComplexity int) int
-		Jailed     func(childComplexity int) int
-		PublicKey  func(childComplexity int) int
-		ServiceURL func(childComplexity int) int
-		Tokens     func(childComplexity int) int
+		Account      func(childComplexity int) int
+		Address      func(childComplexity int) int
+		Height       func(childComplexity int) int
+		Jailed       func(childComplexity int) int
+		PublicKey    func(childComplexity int) int
+		ServiceURL   func(childComplexity int) int
+		Tokens       func(childComplexity int) int
+		Transactions func(childComplexity int, page *int, perPage *int, order *postgresdriver.Order, filter *model.TransactionFilter, first *int, after *string, last *int, before *string) int
 	}
 
 	GraphQLTransaction struct {
 		Amount          func(childComplexity int) int
 		AppPubKey       func(childComplexity int) int
+		Block           func(childComplexity int) int
 		Blockchains     func(childComplexity int) int
 		Entropy         func(childComplexity int) int
 		Fee             func(childComplexity int) int
 		FeeDenomination func(childComplexity int) int
+		FromAccount     func(childComplexity int) int
 		FromAddress     func(childComplexity int) int
 		Hash            func(childComplexity int) int
 		Height          func(childComplexity int) int
 		Index           func(childComplexity int) int
 		MessageType     func(childComplexity int) int
 		StdTx           func(childComplexity int) int
+		ToAccount       func(childComplexity int) int
 		ToAddress       func(childComplexity int) int
 		Tx              func(childComplexity int) int
 		TxResult        func(childComplexity int) int
 	}
 
+	NodeEdge struct {
+		Cursor func(childComplexity int) int
+		Node   func(childComplexity int) int
+	}
+
 	NodesResponse struct {
+		Edges      func(childComplexity int) int
 		Nodes      func(childComplexity int) int
 		Page       func(childComplexity int) int
 		PageCount  func(childComplexity int) int
+		PageInfo   func(childComplexity int) int
 		TotalCount func(childComplexity int) int
 		TotalPages func(childComplexity int) int
 	}
 
+	PageInfo struct {
+		EndCursor       func(childComplexity int) int
+		HasNextPage     func(childComplexity int) int
+		HasPreviousPage func(childComplexity int) int
+		StartCursor     func(childComplexity int) int
+	}
+
 	Query struct {
 		QueryAccountByAddress      func(childComplexity int, address string, height *int) int
-		QueryAccounts              func(childComplexity int, height *int, page *int, perPage *int) int
+		QueryAccounts              func(childComplexity int, height *int, page *int, perPage *int, first *int, after *string, last *int, before *string) int
 		QueryAppByAddress          func(childComplexity int, address string, height *int) int
-		QueryApps                  func(childComplexity int, height *int, page *int, perPage *int) int
+		QueryApps                  func(childComplexity int, height *int, page *int, perPage *int, first *int, after *string, last *int, before *string) int
 		QueryBlockByHash           func(childComplexity int, hash string) int
 		QueryBlockByHeight         func(childComplexity int, height int) int
-		QueryBlocks                func(childComplexity int, page *int, perPage *int, order *postgresdriver.Order) int
+		QueryBlocks                func(childComplexity int, page *int, perPage *int, order *postgresdriver.Order, first *int, after *string, last *int, before *string) int
 		QueryNodeByAddress         func(childComplexity int, address string, height *int) int
-		QueryNodes                 func(childComplexity int, height *int, page *int, perPage *int) int
+		QueryNodes                 func(childComplexity int, height *int, page *int, perPage *int, first *int, after *string, last *int, before *string) int
 		QueryTransactionByHash     func(childComplexity int, hash string) int
-		QueryTransactions          func(childComplexity int, page *int, perPage *int, order *postgresdriver.Order) int
-		QueryTransactionsByAddress func(childComplexity int, address string, page *int, perPage *int) int
-		QueryTransactionsByHeight  func(childComplexity int, height int, page *int, perPage *int) int
+		QueryTransactions          func(childComplexity int, page *int, perPage *int, order *postgresdriver.Order, filter *model.TransactionFilter, first *int, after *string, last *int, before *string) int
+		QueryTransactionsByAddress func(childComplexity int, address string, page *int, perPage *int, order *postgresdriver.Order, filter *model.TransactionFilter, first *int, after *string, last *int, before *string) int
+		QueryTransactionsByHeight  func(childComplexity int, height int, page *int, perPage *int, first *int, after *string, last *int, before *string) int
 	}
 
 	StdTx struct {
@@ -158,9 +206,16 @@ type ComplexityRoot struct {
 		Signature func(childComplexity int) int
 	}
 
+	TransactionEdge struct {
+		Cursor func(childComplexity int) int
+		Node   func(childComplexity int) int
+	}
+
 	TransactionsResponse struct {
+		Edges        func(childComplexity int) int
 		Page         func(childComplexity int) int
 		PageCount    func(childComplexity int) int
+		PageInfo     func(childComplexity int) int
 		TotalCount   func(childComplexity int) int
 		TotalPages   func(childComplexity int) int
 		Transactions func(childComplexity int) int
@@ -189,20 +244,36 @@ type ComplexityRoot struct {
 	}
 }
 
+type BlockResolver interface {
+	Transactions(ctx context.Context, obj *indexer.Block, page *int, perPage *int, filter *model.TransactionFilter, first *int, after *string, last *int, before *string) (*model.TransactionsResponse, error)
+}
+type GraphQLAppResolver interface {
+	Account(ctx context.Context, obj *model.GraphQLApp) (*model.GraphQLAccount, error)
+	Transactions(ctx context.Context, obj *model.GraphQLApp, page *int, perPage *int, order *postgresdriver.Order, filter *model.TransactionFilter, first *int, after *string, last *int, before *string) (*model.TransactionsResponse, error)
+}
+type GraphQLNodeResolver interface {
+	Account(ctx context.Context, obj *model.GraphQLNode) (*model.GraphQLAccount, error)
+	Transactions(ctx context.Context, obj *model.GraphQLNode, page *int, perPage *int, order *postgresdriver.Order, filter *model.TransactionFilter, first *int, after *string, last *int, before *string) (*model.TransactionsResponse, error)
+}
+type GraphQLTransactionResolver interface {
+	Block(ctx context.Context, obj *model.GraphQLTransaction) (*indexer.Block, error)
+	FromAccount(ctx context.Context, obj *model.GraphQLTransaction) (*model.GraphQLAccount, error)
+	ToAccount(ctx context.Context, obj *model.GraphQLTransaction) (*model.GraphQLAccount, error)
+}
 type QueryResolver interface {
 	QueryBlockByHash(ctx context.Context, hash string) (*indexer.Block, error)
 	QueryBlockByHeight(ctx context.Context, height int) (*indexer.Block, error)
-	QueryBlocks(ctx context.Context, page *int, perPage *int, order *postgresdriver.Order) (*model.BlocksResponse, error)
+	QueryBlocks(ctx context.Context, page *int, perPage *int, order *postgresdriver.Order, first *int, after *string, last *int, before *string) (*model.BlocksResponse, error)
 	QueryTransactionByHash(ctx context.Context, hash string) (*model.GraphQLTransaction, error)
-	QueryTransactionsByHeight(ctx context.Context, height int, page *int, perPage *int) (*model.TransactionsResponse, error)
-	QueryTransactions(ctx context.Context, page *int, perPage *int, order *postgresdriver.Order) (*model.TransactionsResponse, error)
-	QueryTransactionsByAddress(ctx context.Context, address string, page *int, perPage *int) (*model.TransactionsResponse, error)
+	QueryTransactionsByHeight(ctx context.Context, height int, page *int, perPage *int, first *int, after *string, last *int, before *string) (*model.TransactionsResponse, error)
+	QueryTransactions(ctx context.Context, page *int, perPage *int, order *postgresdriver.Order, filter *model.TransactionFilter, first *int, after *string, last *int, before *string) (*model.TransactionsResponse, error)
+	QueryTransactionsByAddress(ctx context.Context, address string, page *int, perPage *int, order *postgresdriver.Order, filter *model.TransactionFilter, first *int, after *string, last *int, before *string) (*model.TransactionsResponse, error)
 	QueryAccountByAddress(ctx context.Context, address string, height *int) (*model.GraphQLAccount, error)
-	QueryAccounts(ctx context.Context, height *int, page *int, perPage *int) (*model.AccountsResponse, error)
+	QueryAccounts(ctx context.Context, height *int, page *int, perPage *int, first *int, after *string, last *int, before *string) (*model.AccountsResponse, error)
 	QueryNodeByAddress(ctx context.Context, address string, height *int) (*model.GraphQLNode, error)
-	QueryNodes(ctx context.Context, height *int, page *int, perPage *int) (*model.NodesResponse, error)
+	QueryNodes(ctx context.Context, height *int, page *int, perPage *int, first *int, after *string, last *int, before *string) (*model.NodesResponse, error)
 	QueryAppByAddress(ctx context.Context, address string, height *int) (*model.GraphQLApp, error)
-	QueryApps(ctx context.Context, height *int, page *int, perPage *int) (*model.AppsResponse, error)
+	QueryApps(ctx context.Context, height *int, page *int, perPage *int, first *int, after *string, last *int, before *string) (*model.AppsResponse, error)
 }
 
 type executableSchema struct {
@@ -220,6 +291,20 @@ func (e *executableSchema) Complexity(typeName, field string, childComplexity in
 	_ = ec
 	switch typeName + "." + field {
 
+	case "AccountEdge.cursor":
+		if e.complexity.AccountEdge.Cursor == nil {
+			break
+		}
+
+		return e.complexity.AccountEdge.Cursor(childComplexity), true
+
+	case "AccountEdge.node":
+		if e.complexity.AccountEdge.Node == nil {
+			break
+		}
+
+		return e.complexity.AccountEdge.Node(childComplexity), true
+
 	case "AccountsResponse.accounts":
 		if e.complexity.AccountsResponse.Accounts == nil {
 			break
@@ -227,6 +312,13 @@ func (e *executableSchema) Complexity(typeName, field string, childComplexity in
 
 		return e.complexity.AccountsResponse.Accounts(childComplexity), true
 
+	case "AccountsResponse.edges":
+		if e.complexity.AccountsResponse.Edges == nil {
+			break
+		}
+
+		return e.complexity.AccountsResponse.Edges(childComplexity), true
+
 	case "AccountsResponse.page":
 		if e.complexity.AccountsResponse.Page == nil {
 			break
@@ -241,6 +333,13 @@ func (e *executableSchema) Complexity(typeName, field string, childComplexity in
 
 		return e.complexity.AccountsResponse.PageCount(childComplexity), true
 
+	case "AccountsResponse.pageInfo":
+		if e.complexity.AccountsResponse.PageInfo == nil {
+			break
+		}
+
+		return e.complexity.AccountsResponse.PageInfo(childComplexity), true
+
 	case "AccountsResponse.totalCount":
 		if e.complexity.AccountsResponse.TotalCount == nil {
 			break
@@ -255,6 +354,20 @@ func (e *executableSchema) Complexity(typeName, field string, childComplexity in
 
 		return e.complexity.AccountsResponse.TotalPages(childComplexity), true
 
+	case "AppEdge.cursor":
+		if e.complexity.AppEdge.Cursor == nil {
+			break
+		}
+
+		return e.complexity.AppEdge.Cursor(childComplexity), true
+
+	case "AppEdge.node":
+		if e.complexity.AppEdge.Node == nil {
+			break
+		}
+
+		return e.complexity.AppEdge.Node(childComplexity), true
+
 	case "AppsResponse.apps":
 		if e.complexity.AppsResponse.Apps == nil {
 			break
@@ -262,6 +375,13 @@ func (e *executableSchema) Complexity(typeName, field string, childComplexity in
 
 		return e.complexity.AppsResponse.Apps(childComplexity), true
 
+	case "AppsResponse.edges":
+		if e.complexity.AppsResponse.Edges == nil {
+			break
+		}
+
+		return e.complexity.AppsResponse.Edges(childComplexity), true
+
 	case "AppsResponse.page":
 		if e.complexity.AppsResponse.Page == nil {
 			break
@@ -276,6 +396,13 @@ func (e *executableSchema) Complexity(typeName, field string, childComplexity in
 
 		return e.complexity.AppsResponse.PageCount(childComplexity), true
 
+	case "AppsResponse.pageInfo":
+		if e.complexity.AppsResponse.PageInfo == nil {
+			break
+		}
+
+		return e.complexity.AppsResponse.PageInfo(childComplexity), true
+
 	case "AppsResponse.totalCount":
 		if e.complexity.AppsResponse.TotalCount == nil {
 			break
@@ -325,6 +452,32 @@ func (e *executableSchema) Complexity(typeName, field string, childComplexity in
 
 		return e.complexity.Block.Time(childComplexity), true
 
+	case "Block.transactions":
+		if e.complexity.Block.Transactions == nil {
+			break
+		}
+
+		args, err := ec.field_Block_transactions_args(context.TODO(), rawArgs)
+		if err != nil {
+			return 0, false
+		}
+
+		return e.complexity.Block.Transactions(childComplexity, args["page"].(*int), args["perPage"].(*int), args["filter"].(*model.TransactionFilter), args["first"].(*int), args["after"].(*string), args["last"].(*int), args["before"].(*string)), true
+
+	case "BlockEdge.cursor":
+		if e.complexity.BlockEdge.Cursor == nil {
+			break
+		}
+
+		return e.complexity.BlockEdge.Cursor(childComplexity), true
+
+	case "BlockEdge.node":
+		if e.complexity.BlockEdge.Node == nil {
+			break
+		}
+
+		return e.complexity.BlockEdge.Node(childComplexity), true
+
 	case "BlocksResponse.blocks":
 		if e.complexity.BlocksResponse.Blocks == nil {
 			break
@@ -332,6 +485,13 @@ func (e *executableSchema) Complexity(typeName, field string, childComplexity in
 
 		return e.complexity.BlocksResponse.Blocks(childComplexity), true
 
+	case "BlocksResponse.edges":
+		if e.complexity.BlocksResponse.Edges == nil {
+			break
+		}
+
+		return e.complexity.BlocksResponse.Edges(childComplexity), true
+
 	case "BlocksResponse.page":
 		if e.complexity.BlocksResponse.Page == nil {
 			break
@@ -346,6 +506,13 @@ func (e *executableSchema) Complexity(typeName, field string, childComplexity in
 
 		return e.complexity.BlocksResponse.PageCount(childComplexity), true
 
+	case "BlocksResponse.pageInfo":
+		if e.complexity.BlocksResponse.PageInfo == nil {
+			break
+		}
+
+		return e.complexity.BlocksResponse.PageInfo(childComplexity), true
+
 	case "BlocksResponse.totalCount":
 		if e.complexity.BlocksResponse.TotalCount == nil {
 			break
@@ -409,6 +576,13 @@ func (e *executableSchema) Complexity(typeName, field string, childComplexity in
 
 		return e.complexity.GraphQLAccount.Height(childComplexity), true
 
+	case "GraphQLApp.account":
+		if e.complexity.GraphQLApp.Account == nil {
+			break
+		}
+
+		return e.complexity.GraphQLApp.Account(childComplexity), true
+
 	case "GraphQLApp.address":
 		if e.complexity.GraphQLApp.Address == nil {
 			break
@@ -444,6 +618,25 @@ func (e *executableSchema) Complexity(typeName, field string, childComplexity in
 
 		return e.complexity.GraphQLApp.StakedTokens(childComplexity), true
 
+	case "GraphQLApp.transactions":
+		if e.complexity.GraphQLApp.Transactions == nil {
+			break
+		}
+
+		args, err := ec.field_GraphQLApp_transactions_args(context.TODO(), rawArgs)
+		if err != nil {
+			return 0, false
+		}
+
+		return e.complexity.GraphQLApp.Transactions(childComplexity, args["page"].(*int), args["perPage"].(*int), args["order"].(*postgresdriver.Order), args["filter"].(*model.TransactionFilter), args["first"].(*int), args["after"].(*string), args["last"].(*int), args["before"].(*string)), true
+
+	case "GraphQLNode.account":
+		if e.complexity.GraphQLNode.Account == nil {
+			break
+		}
+
+		return e.complexity.GraphQLNode.Account(childComplexity), true
+
 	case "GraphQLNode.address":
 		if e.complexity.GraphQLNode.Address == nil {
 			break
@@ -486,6 +679,18 @@ func (e *executableSchema) Complexity(typeName, field string, childComplexity in
 
 		return e.complexity.GraphQLNode.Tokens(childComplexity), true
 
+	case "GraphQLNode.transactions":
+		if e.complexity.GraphQLNode.Transactions == nil {
+			break
+		}
+
+		args, err := ec.field_GraphQLNode_transactions_args(context.TODO(), rawArgs)
+		if err != nil {
+			return 0, false
+		}
+
+		return e.complexity.GraphQLNode.Transactions(childComplexity, args["page"].(*int), args["perPage"].(*int), args["order"].(*postgresdriver.Order), args["filter"].(*model.TransactionFilter), args["first"].(*int), args["after"].(*string), args["last"].(*int), args["before"].(*string)), true
+
 	case "GraphQLTransaction.amount":
 		if e.complexity.GraphQLTransaction.Amount == nil {
 			break
@@ -500,6 +705,13 @@ func (e *executableSchema) Complexity(typeName, field string, childComplexity in
 
 		return e.complexity.GraphQLTransaction.AppPubKey(childComplexity), true
 
+	case "GraphQLTransaction.block":
+		if e.complexity.GraphQLTransaction.Block == nil {
+			break
+		}
+
+		return e.complexity.GraphQLTransaction.Block(childComplexity), true
+
 	case "GraphQLTransaction.blockchains":
 		if e.complexity.GraphQLTransaction.Blockchains == nil {
 			break
@@ -528,6 +740,13 @@ func (e *executableSchema) Complexity(typeName, field string, childComplexity in
 
 		return e.complexity.GraphQLTransaction.FeeDenomination(childComplexity), true
 
+	case "GraphQLTransaction.fromAccount":
+		if e.complexity.GraphQLTransaction.FromAccount == nil {
+			break
+		}
+
+		return e.complexity.GraphQLTransaction.FromAccount(childComplexity), true
+
 	case "GraphQLTransaction.fromAddress":
 		if e.complexity.GraphQLTransaction.FromAddress == nil {
 			break
@@ -570,6 +789,13 @@ func (e *executableSchema) Complexity(typeName, field string, childComplexity in
 
 		return e.complexity.GraphQLTransaction.StdTx(childComplexity), true
 
+	case "GraphQLTransaction.toAccount":
+		if e.complexity.GraphQLTransaction.ToAccount == nil {
+			break
+		}
+
+		return e.complexity.GraphQLTransaction.ToAccount(childComplexity), true
+
 	case "GraphQLTransaction.toAddress":
 		if e.complexity.GraphQLTransaction.ToAddress == nil {
 			break
@@ -591,6 +817,27 @@ func (e *executableSchema) Complexity(typeName, field string, childComplexity in
 
 		return e.complexity.GraphQLTransaction.TxResult(childComplexity), true
 
+	case "NodeEdge.cursor":
+		if e.complexity.NodeEdge.Cursor == nil {
+			break
+		}
+
+		return e.complexity.NodeEdge.Cursor(childComplexity), true
+
+	case "NodeEdge.node":
+		if e.complexity.NodeEdge.Node == nil {
+			break
+		}
+
+		return e.complexity.NodeEdge.Node(childComplexity), true
+
+	case "NodesResponse.edges":
+		if e.complexity.NodesResponse.Edges == nil {
+			break
+		}
+
+		return e.complexity.NodesResponse.Edges(childComplexity), true
+
 	case "NodesResponse.nodes":
 		if e.complexity.NodesResponse.Nodes == nil {
 			break
@@ -612,6 +859,13 @@ func (e *executableSchema) Complexity(typeName, field string, childComplexity in
 
 		return e.complexity.NodesResponse.PageCount(childComplexity), true
 
+	case "NodesResponse.pageInfo":
+		if e.complexity.NodesResponse.PageInfo == nil {
+			break
+		}
+
+		return e.complexity.NodesResponse.PageInfo(childComplexity), true
+
 	case "NodesResponse.totalCount":
 		if e.complexity.NodesResponse.TotalCount == nil {
 			break
@@ -626,6 +880,34 @@ func (e *executableSchema) Complexity(typeName, field string, childComplexity in
 
 		return e.complexity.NodesResponse.TotalPages(childComplexity), true
 
+	case "PageInfo.endCursor":
+		if e.complexity.PageInfo.EndCursor == nil {
+			break
+		}
+
+		return e.complexity.PageInfo.EndCursor(childComplexity), true
+
+	case "PageInfo.hasNextPage":
+		if e.complexity.PageInfo.HasNextPage == nil {
+			break
+		}
+
+		return e.complexity.PageInfo.HasNextPage(childComplexity), true
+
+	case "PageInfo.hasPreviousPage":
+		if e.complexity.PageInfo.HasPreviousPage == nil {
+			break
+		}
+
+		return e.complexity.PageInfo.HasPreviousPage(childComplexity), true
+
+	case "PageInfo.startCursor":
+		if e.complexity.PageInfo.StartCursor == nil {
+			break
+		}
+
+		return e.complexity.PageInfo.StartCursor(childComplexity), true
+
 	case "Query.queryAccountByAddress":
 		if e.complexity.Query.QueryAccountByAddress == nil {
 			break
@@ -648,7 +930,7 @@ func (e *executableSchema) Complexity(typeName, field string, childComplexity in
 			return 0, false
 		}
 
-		return e.complexity.Query.QueryAccounts(childComplexity, args["height"].(*int), args["page"].(*int), args["perPage"].(*int)), true
+		return e.complexity.Query.QueryAccounts(childComplexity, args["height"].(*int), args["page"].(*int), args["perPage"].(*int), args["first"].(*int), args["after"].(*string), args["last"].(*int), args["before"].(*string)), true
 
 	case "Query.queryAppByAddress":
 		if e.complexity.Query.QueryAppByAddress == nil {
@@ -672,7 +954,7 @@ func (e *executableSchema) Complexity(typeName, field string, childComplexity in
 			return 0, false
 		}
 
-		return e.complexity.Query.QueryApps(childComplexity, args["height"].(*int), args["page"].(*int), args["perPage"].(*int)), true
+		return e.complexity.Query.QueryApps(childComplexity, args["height"].(*int), args["page"].(*int), args["perPage"].(*int), args["first"].(*int), args["after"].(*string), args["last"].(*int), args["before"].(*string)), true
 
 	case "Query.queryBlockByHash":
 		if e.complexity.Query.QueryBlockByHash == nil {
@@ -708,7 +990,7 @@ func (e *executableSchema) Complexity(typeName, field string, childComplexity in
 			return 0, false
 		}
 
-		return e.complexity.Query.QueryBlocks(childComplexity, args["page"].(*int), args["perPage"].(*int), args["order"].(*postgresdriver.Order)), true
+		return e.complexity.Query.QueryBlocks(childComplexity, args["page"].(*int), args["perPage"].(*int), args["order"].(*postgresdriver.Order), args["first"].(*int), args["after"].(*string), args["last"].(*int), args["before"].(*string)), true
 
 	case "Query.queryNodeByAddress":
 		if e.complexity.Query.QueryNodeByAddress == nil {
@@ -732,7 +1014,7 @@ func (e *executableSchema) Complexity(typeName, field string, childComplexity in
 			return 0, false
 		}
 
-		return e.complexity.Query.QueryNodes(childComplexity, args["height"].(*int), args["page"].(*int), args["perPage"].(*int)), true
+		return e.complexity.Query.QueryNodes(childComplexity, args["height"].(*int), args["page"].(*int), args["perPage"].(*int), args["first"].(*int), args["after"].(*string), args["last"].(*int), args["before"].(*string)), true
 
 	case "Query.queryTransactionByHash":
 		if e.complexity.Query.QueryTransactionByHash == nil {
@@ -756,7 +1038,7 @@ func (e *executableSchema) Complexity(typeName, field string, childComplexity in
 			return 0, false
 		}
 
-		return e.complexity.Query.QueryTransactions(childComplexity, args["page"].(*int), args["perPage"].(*int), args["order"].(*postgresdriver.Order)), true
+		return e.complexity.Query.QueryTransactions(childComplexity, args["page"].(*int), args["perPage"].(*int), args["order"].(*postgresdriver.Order), args["filter"].(*model.TransactionFilter), args["first"].(*int), args["after"].(*string), args["last"].(*int), args["before"].(*string)), true
 
 	case "Query.queryTransactionsByAddress":
 		if e.complexity.Query.QueryTransactionsByAddress == nil {
@@ -768,7 +1050,7 @@ func (e *executableSchema) Complexity(typeName, field string, childComplexity in
 			return 0, false
 		}
 
-		return e.complexity.Query.QueryTransactionsByAddress(childComplexity, args["address"].(string), args["page"].(*int), args["perPage"].(*int)), true
+		return e.complexity.Query.QueryTransactionsByAddress(childComplexity, args["address"].(string), args["page"].(*int), args["perPage"].(*int), args["order"].(*postgresdriver.Order), args["filter"].(*model.TransactionFilter), args["first"].(*int), args["after"].(*string), args["last"].(*int), args["before"].(*string)), true
 
 	case "Query.queryTransactionsByHeight":
 		if e.complexity.Query.QueryTransactionsByHeight == nil {
@@ -780,7 +1062,7 @@ func (e *executableSchema) Complexity(typeName, field string, childComplexity in
 			return 0, false
 		}
 
-		return e.complexity.Query.QueryTransactionsByHeight(childComplexity, args["height"].(int), args["page"].(*int), args["perPage"].(*int)), true
+		return e.complexity.Query.QueryTransactionsByHeight(childComplexity, args["height"].(int), args["page"].(*int), args["perPage"].(*int), args["first"].(*int), args["after"].(*string), args["last"].(*int), args["before"].(*string)), true
 
 	case "StdTx.entropy":
 		if e.complexity.StdTx.Entropy == nil {
@@ -817,6 +1099,27 @@ func (e *executableSchema) Complexity(typeName, field string, childComplexity in
 
 		return e.complexity.StdTx.Signature(childComplexity), true
 
+	case "TransactionEdge.cursor":
+		if e.complexity.TransactionEdge.Cursor == nil {
+			break
+		}
+
+		return e.complexity.TransactionEdge.Cursor(childComplexity), true
+
+	case "TransactionEdge.node":
+		if e.complexity.TransactionEdge.Node == nil {
+			break
+		}
+
+		return e.complexity.TransactionEdge.Node(childComplexity), true
+
+	case "TransactionsResponse.edges":
+		if e.complexity.TransactionsResponse.Edges == nil {
+			break
+		}
+
+		return e.complexity.TransactionsResponse.Edges(childComplexity), true
+
 	case "TransactionsResponse.page":
 		if e.complexity.TransactionsResponse.Page == nil {
 			break
@@ -831,6 +1134,13 @@ func (e *executableSchema) Complexity(typeName, field string, childComplexity in
 
 		return e.complexity.TransactionsResponse.PageCount(childComplexity), true
 
+	case "TransactionsResponse.pageInfo":
+		if e.complexity.TransactionsResponse.PageInfo == nil {
+			break
+		}
+
+		return e.complexity.TransactionsResponse.PageInfo(childComplexity), true
+
 	case "TransactionsResponse.totalCount":
 		if e.complexity.TransactionsResponse.TotalCount == nil {
 			break
@@ -950,7 +1260,9 @@ func (e *executableSchema) Complexity(typeName, field string, childComplexity in
 func (e *executableSchema) Exec(ctx context.Context) graphql.ResponseHandler {
 	rc := graphql.GetOperationContext(ctx)
 	ec := executionContext{rc, e}
-	inputUnmarshalMap := graphql.BuildUnmarshalerMap()
+	inputUnmarshalMap := graphql.BuildUnmarshalerMap(
+		ec.unmarshalInputTransactionFilter,
+	)
 	first := true
 
 	switch rc.Operation.Operation {
@@ -1004,6 +1316,16 @@ type Block {
   time: Time!
   proposerAddress: String!
   txCount: Int!
+  # Transactions of the block by index
+  transactions(
+    page: Int
+    perPage: Int
+    filter: TransactionFilter
+    first: Int
+    after: String
+    last: Int
+    before: String
+  ): TransactionsResponse
 }
 
 type GraphQLTransaction {
@@ -1022,6 +1344,10 @@ type GraphQLTransaction {
   fee: Int!
   feeDenomination: String!
   amount: String!
+  block: Block
+  # Accounts of the sender and the recipient as of the height of the transaction
+  fromAccount: GraphQLAccount
+  toAccount: GraphQLAccount
 }
 
 type StdTx {
@@ -1074,6 +1400,19 @@ type GraphQLNode {
   publicKey: String!
   serviceURL: String!
   tokens: String!
+  # Account of the node as of its height
+  account: GraphQLAccount
+  # Transactions the node sent or received, the newest first by default
+  transactions(
+    page: Int
+    perPage: Int
+    order: Order
+    filter: TransactionFilter
+    first: Int
+    after: String
+    last: Int
+    before: String
+  ): TransactionsResponse
 }
 
 type GraphQLApp {
@@ -1082,6 +1421,32 @@ type GraphQLApp {
   jailed: Boolean!
   publicKey: String!
   stakedTokens: String!
+  # Account of the app as of its height
+  account: GraphQLAccount
+  # Transactions the app sent or received, the newest first by default
+  transactions(
+    page: Int
+    perPage: Int
+    order: Order
+    filter: TransactionFilter
+    first: Int
+    after: String
+    last: Int
+    before: String
+  ): TransactionsResponse
+}
+
+# Relay page info, the cursors are the ones of the first and last edges
+type PageInfo {
+  hasNextPage: Boolean!
+  hasPreviousPage: Boolean!
+  startCursor: String
+  endCursor: String
+}
+
+type BlockEdge {
+  cursor: String!
+  node: Block!
 }
 
 type BlocksResponse {
@@ -1090,6 +1455,13 @@ type BlocksResponse {
   pageCount: Int!
   page: Int!
   totalPages: Int!
+  edges: [BlockEdge!]!
+  pageInfo: PageInfo!
+}
+
+type TransactionEdge {
+  cursor: String!
+  node: GraphQLTransaction!
 }
 
 type TransactionsResponse {
@@ -1098,6 +1470,13 @@ type TransactionsResponse {
   pageCount: Int!
   page: Int!
   totalPages: Int!
+  edges: [TransactionEdge!]!
+  pageInfo: PageInfo!
+}
+
+type AccountEdge {
+  cursor: String!
+  node: GraphQLAccount!
 }
 
 type AccountsResponse {
@@ -1106,6 +1485,13 @@ type AccountsResponse {
   pageCount: Int!
   page: Int!
   totalPages: Int!
+  edges: [AccountEdge!]!
+  pageInfo: PageInfo!
+}
+
+type NodeEdge {
+  cursor: String!
+  node: GraphQLNode!
 }
 
 type NodesResponse {
@@ -1114,6 +1500,13 @@ type NodesResponse {
   pageCount: Int!
   page: Int!
   totalPages: Int!
+  edges: [NodeEdge!]!
+  pageInfo: PageInfo!
+}
+
+type AppEdge {
+  cursor: String!
+  node: GraphQLApp!
 }
 
 type AppsResponse {
@@ -1122,6 +1515,8 @@ type AppsResponse {
   pageCount: Int!
   page: Int!
   totalPages: Int!
+  edges: [AppEdge!]!
+  pageInfo: PageInfo!
 }
 
 enum Order {
@@ -1129,28 +1524,110 @@ enum Order {
   desc
 }
 
+enum TransactionDirection {
+  sent
+  received
+}
+
+enum TransactionResult {
+  success
+  failure
+}
+
+# Filters of the transactions, every filter set must match
+# blockchains keeps the transactions of all the blockchains given, the times are the ones of their blocks
+# and the amounts are decimal strings, direction only applies to the transactions of an address
+input TransactionFilter {
+  messageType: String
+  blockchains: [String!]
+  fromHeight: Int
+  toHeight: Int
+  fromTime: Time
+  toTime: Time
+  direction: TransactionDirection
+  result: TransactionResult
+  minFee: Int
+  maxFee: Int
+  minAmount: String
+  maxAmount: String
+}
+
+# The list queries are paginated either by page and perPage or, as Relay connections, by first and after
+# or last and before with the cursors of the edges, which don't drift while new rows are indexed
+# Cursor pages report page 0, they and the transactions pages only count their rows when totalCount or totalPages is selected
 type Query {
   queryBlockByHash(hash: String!): Block
   queryBlockByHeight(height: Int!): Block
-  queryBlocks(page: Int, perPage: Int, order: Order): BlocksResponse
+  queryBlocks(
+    page: Int
+    perPage: Int
+    order: Order
+    first: Int
+    after: String
+    last: Int
+    before: String
+  ): BlocksResponse
   queryTransactionByHash(hash: String!): GraphQLTransaction
   queryTransactionsByHeight(
     height: Int!
     page: Int
     perPage: Int
+    first: Int
+    after: String
+    last: Int
+    before: String
+  ): TransactionsResponse
+  queryTransactions(
+    page: Int
+    perPage: Int
+    order: Order
+    filter: TransactionFilter
+    first: Int
+    after: String
+    last: Int
+    before: String
   ): TransactionsResponse
-  queryTransactions(page: Int, perPage: Int, order: Order): TransactionsResponse
   queryTransactionsByAddress(
     address: String!
     page: Int
     perPage: Int
+    order: Order
+    filter: TransactionFilter
+    first: Int
+    after: String
+    last: Int
+    before: String
   ): TransactionsResponse
   queryAccountByAddress(address: String!, height: Int): GraphQLAccount
-  queryAccounts(height: Int, page: Int, perPage: Int): AccountsResponse
+  queryAccounts(
+    height: Int
+    page: Int
+    perPage: Int
+    first: Int
+    after: String
+    last: Int
+    before: String
+  ): AccountsResponse
   queryNodeByAddress(address: String!, height: Int): GraphQLNode
-  queryNodes(height: Int, page: Int, perPage: Int): NodesResponse
+  queryNodes(
+    height: Int
+    page: Int
+    perPage: Int
+    first: Int
+    after: String
+    last: Int
+    before: String
+  ): NodesResponse
   queryAppByAddress(address: String!, height: Int): GraphQLApp
-  queryApps(height: Int, page: Int, perPage: Int): AppsResponse
+  queryApps(
+    height: Int
+    page: Int
+    perPage: Int
+    first: Int
+    after: String
+    last: Int
+    before: String
+  ): AppsResponse
 }
 `, BuiltIn: false},
 }
@@ -1160,82 +1637,343 @@ var parsedSchema = gqlparser.MustLoadSchema(sources...)
 
 // region    ***************************** args.gotpl *****************************
 
-func (ec *executionContext) field_Query___type_args(ctx context.Context, rawArgs map[string]interface{}) (map[string]interface{}, error) {
+func (ec *executionContext) field_Block_transactions_args(ctx context.Context, rawArgs map[string]interface{}) (map[string]interface{}, error) {
 	var err error
 	args := map[string]interface{}{}
-	var arg0 string
-	if tmp, ok := rawArgs["name"]; ok {
-		ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("name"))
-		arg0, err = ec.unmarshalNString2string(ctx, tmp)
+	var arg0 *int
+	if tmp, ok := rawArgs["page"]; ok {
+		ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("page"))
+		arg0, err = ec.unmarshalOInt2ᚖint(ctx, tmp)
 		if err != nil {
 			return nil, err
 		}
 	}
-	args["name"] = arg0
-	return args, nil
-}
-
-func (ec *executionContext) field_Query_queryAccountByAddress_args(ctx context.Context, rawArgs map[string]interface{}) (map[string]interface{}, error) {
-	var err error
-	args := map[string]interface{}{}
-	var arg0 string
-	if tmp, ok := rawArgs["address"]; ok {
-		ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("address"))
-		arg0, err = ec.unmarshalNString2string(ctx, tmp)
+	args["page"] = arg0
+	var arg1 *int
+	if tmp, ok := rawArgs["perPage"]; ok {
+		ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("perPage"))
+		arg1, err = ec.unmarshalOInt2ᚖint(ctx, tmp)
 		if err != nil {
 			return nil, err
 		}
 	}
-	args["address"] = arg0
-	var arg1 *int
-	if tmp, ok := rawArgs["height"]; ok {
-		ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("height"))
-		arg1, err = ec.unmarshalOInt2ᚖint(ctx, tmp)
+	args["perPage"] = arg1
+	var arg2 *model.TransactionFilter
+	if tmp, ok := rawArgs["filter"]; ok {
+		ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("filter"))
+		arg2, err = ec.unmarshalOTransactionFilter2ᚖgithubᚗcomᚋpoktᚑfoundationᚋpocketᚑindexerᚑservicesᚋapiᚋgraphᚋmodelᚐTransactionFilter(ctx, tmp)
 		if err != nil {
 			return nil, err
 		}
 	}
-	args["height"] = arg1
-	return args, nil
-}
-
-func (ec *executionContext) field_Query_queryAccounts_args(ctx context.Context, rawArgs map[string]interface{}) (map[string]interface{}, error) {
-	var err error
-	args := map[string]interface{}{}
-	var arg0 *int
-	if tmp, ok := rawArgs["height"]; ok {
-		ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("height"))
-		arg0, err = ec.unmarshalOInt2ᚖint(ctx, tmp)
+	args["filter"] = arg2
+	var arg3 *int
+	if tmp, ok := rawArgs["first"]; ok {
+		ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("first"))
+		arg3, err = ec.unmarshalOInt2ᚖint(ctx, tmp)
 		if err != nil {
 			return nil, err
 		}
 	}
-	args["height"] = arg0
-	var arg1 *int
-	if tmp, ok := rawArgs["page"]; ok {
-		ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("page"))
-		arg1, err = ec.unmarshalOInt2ᚖint(ctx, tmp)
+	args["first"] = arg3
+	var arg4 *string
+	if tmp, ok := rawArgs["after"]; ok {
+		ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("after"))
+		arg4, err = ec.unmarshalOString2ᚖstring(ctx, tmp)
 		if err != nil {
 			return nil, err
 		}
 	}
-	args["page"] = arg1
-	var arg2 *int
-	if tmp, ok := rawArgs["perPage"]; ok {
-		ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("perPage"))
-		arg2, err = ec.unmarshalOInt2ᚖint(ctx, tmp)
+	args["after"] = arg4
+	var arg5 *int
+	if tmp, ok := rawArgs["last"]; ok {
+		ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("last"))
+		arg5, err = ec.unmarshalOInt2ᚖint(ctx, tmp)
 		if err != nil {
 			return nil, err
 		}
 	}
-	args["perPage"] = arg2
+	args["last"] = arg5
+	var arg6 *string
+	if tmp, ok := rawArgs["before"]; ok {
+		ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("before"))
+		arg6, err = ec.unmarshalOString2ᚖstring(ctx, tmp)
+		if err != nil {
+			return nil, err
+		}
+	}
+	args["before"] = arg6
 	return args, nil
 }
 
-func (ec *executionContext) field_Query_queryAppByAddress_args(ctx context.Context, rawArgs map[string]interface{}) (map[string]interface{}, error) {
+func (ec *executionContext) field_GraphQLApp_transactions_args(ctx context.Context, rawArgs map[string]interface{}) (map[string]interface{}, error) {
 	var err error
 	args := map[string]interface{}{}
-	var arg0 string
+	var arg0 *int
+	if tmp, ok := rawArgs["page"]; ok {
+		ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("page"))
+		arg0, err = ec.unmarshalOInt2ᚖint(ctx, tmp)
+		if err != nil {
+			return nil, err
+		}
+	}
+	args["page"] = arg0
+	var arg1 *int
+	if tmp, ok := rawArgs["perPage"]; ok {
+		ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("perPage"))
+		arg1, err = ec.unmarshalOInt2ᚖint(ctx, tmp)
+		if err != nil {
+			return nil, err
+		}
+	}
+	args["perPage"] = arg1
+	var arg2 *postgresdriver.Order
+	if tmp, ok := rawArgs["order"]; ok {
+		ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("order"))
+		arg2, err = ec.unmarshalOOrder2ᚖgithubᚗcomᚋpoktᚑfoundationᚋpocketᚑindexerᚑlibᚋpostgresᚑdriverᚐOrder(ctx, tmp)
+		if err != nil {
+			return nil, err
+		}
+	}
+	args["order"] = arg2
+	var arg3 *model.TransactionFilter
+	if tmp, ok := rawArgs["filter"]; ok {
+		ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("filter"))
+		arg3, err = ec.unmarshalOTransactionFilter2ᚖgithubᚗcomᚋpoktᚑfoundationᚋpocketᚑindexerᚑservicesᚋapiᚋgraphᚋmodelᚐTransactionFilter(ctx, tmp)
+		if err != nil {
+			return nil, err
+		}
+	}
+	args["filter"] = arg3
+	var arg4 *int
+	if tmp, ok := rawArgs["first"]; ok {
+		ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("first"))
+		arg4, err = ec.unmarshalOInt2ᚖint(ctx, tmp)
+		if err != nil {
+			return nil, err
+		}
+	}
+	args["first"] = arg4
+	var arg5 *string
+	if tmp, ok := rawArgs["after"]; ok {
+		ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("after"))
+		arg5, err = ec.unmarshalOString2ᚖstring(ctx, tmp)
+		if err != nil {
+			return nil, err
+		}
+	}
+	args["after"] = arg5
+	var arg6 *int
+	if tmp, ok := rawArgs["last"]; ok {
+		ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("last"))
+		arg6, err = ec.unmarshalOInt2ᚖint(ctx, tmp)
+		if err != nil {
+			return nil, err
+		}
+	}
+	args["last"] = arg6
+	var arg7 *string
+	if tmp, ok := rawArgs["before"]; ok {
+		ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("before"))
+		arg7, err = ec.unmarshalOString2ᚖstring(ctx, tmp)
+		if err != nil {
+			return nil, err
+		}
+	}
+	args["before"] = arg7
+	return args, nil
+}
+
+func (ec *executionContext) field_GraphQLNode_transactions_args(ctx context.Context, rawArgs map[string]interface{}) (map[string]interface{}, error) {
+	var err error
+	args := map[string]interface{}{}
+	var arg0 *int
+	if tmp, ok := rawArgs["page"]; ok {
+		ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("page"))
+		arg0, err = ec.unmarshalOInt2ᚖint(ctx, tmp)
+		if err != nil {
+			return nil, err
+		}
+	}
+	args["page"] = arg0
+	var arg1 *int
+	if tmp, ok := rawArgs["perPage"]; ok {
+		ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("perPage"))
+		arg1, err = ec.unmarshalOInt2ᚖint(ctx, tmp)
+		if err != nil {
+			return nil, err
+		}
+	}
+	args["perPage"] = arg1
+	var arg2 *postgresdriver.Order
+	if tmp, ok := rawArgs["order"]; ok {
+		ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("order"))
+		arg2, err = ec.unmarshalOOrder2ᚖgithubᚗcomᚋpoktᚑfoundationᚋpocketᚑindexerᚑlibᚋpostgresᚑdriverᚐOrder(ctx, tmp)
+		if err != nil {
+			return nil, err
+		}
+	}
+	args["order"] = arg2
+	var arg3 *model.TransactionFilter
+	if tmp, ok := rawArgs["filter"]; ok {
+		ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("filter"))
+		arg3, err = ec.unmarshalOTransactionFilter2ᚖgithubᚗcomᚋpoktᚑfoundationᚋpocketᚑindexerᚑservicesᚋapiᚋgraphᚋmodelᚐTransactionFilter(ctx, tmp)
+		if err != nil {
+			return nil, err
+		}
+	}
+	args["filter"] = arg3
+	var arg4 *int
+	if tmp, ok := rawArgs["first"]; ok {
+		ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("first"))
+		arg4, err = ec.unmarshalOInt2ᚖint(ctx, tmp)
+		if err != nil {
+			return nil, err
+		}
+	}
+	args["first"] = arg4
+	var arg5 *string
+	if tmp, ok := rawArgs["after"]; ok {
+		ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("after"))
+		arg5, err = ec.unmarshalOString2ᚖstring(ctx, tmp)
+		if err != nil {
+			return nil, err
+		}
+	}
+	args["after"] = arg5
+	var arg6 *int
+	if tmp, ok := rawArgs["last"]; ok {
+		ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("last"))
+		arg6, err = ec.unmarshalOInt2ᚖint(ctx, tmp)
+		if err != nil {
+			return nil, err
+		}
+	}
+	args["last"] = arg6
+	var arg7 *string
+	if tmp, ok := rawArgs["before"]; ok {
+		ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("before"))
+		arg7, err = ec.unmarshalOString2ᚖstring(ctx, tmp)
+		if err != nil {
+			return nil, err
+		}
+	}
+	args["before"] = arg7
+	return args, nil
+}
+
+func (ec *executionContext) field_Query___type_args(ctx context.Context, rawArgs map[string]interface{}) (map[string]interface{}, error) {
+	var err error
+	args := map[string]interface{}{}
+	var arg0 string
+	if tmp, ok := rawArgs["name"]; ok {
+		ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("name"))
+		arg0, err = ec.unmarshalNString2string(ctx, tmp)
+		if err != nil {
+			return nil, err
+		}
+	}
+	args["name"] = arg0
+	return args, nil
+}
+
+func (ec *executionContext) field_Query_queryAccountByAddress_args(ctx context.Context, rawArgs map[string]interface{}) (map[string]interface{}, error) {
+	var err error
+	args := map[string]interface{}{}
+	var arg0 string
+	if tmp, ok := rawArgs["address"]; ok {
+		ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("address"))
+		arg0, err = ec.unmarshalNString2string(ctx, tmp)
+		if err != nil {
+			return nil, err
+		}
+	}
+	args["address"] = arg0
+	var arg1 *int
+	if tmp, ok := rawArgs["height"]; ok {
+		ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("height"))
+		arg1, err = ec.unmarshalOInt2ᚖint(ctx, tmp)
+		if err != nil {
+			return nil, err
+		}
+	}
+	args["height"] = arg1
+	return args, nil
+}
+
+func (ec *executionContext) field_Query_queryAccounts_args(ctx context.Context, rawArgs map[string]interface{}) (map[string]interface{}, error) {
+	var err error
+	args := map[string]interface{}{}
+	var arg0 *int
+	if tmp, ok := rawArgs["height"]; ok {
+		ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("height"))
+		arg0, err = ec.unmarshalOInt2ᚖint(ctx, tmp)
+		if err != nil {
+			return nil, err
+		}
+	}
+	args["height"] = arg0
+	var arg1 *int
+	if tmp, ok := rawArgs["page"]; ok {
+		ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("page"))
+		arg1, err = ec.unmarshalOInt2ᚖint(ctx, tmp)
+		if err != nil {
+			return nil, err
+		}
+	}
+	args["page"] = arg1
+	var arg2 *int
+	if tmp, ok := rawArgs["perPage"]; ok {
+		ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("perPage"))
+		arg2, err = ec.unmarshalOInt2ᚖint(ctx, tmp)
+		if err != nil {
+			return nil, err
+		}
+	}
+	args["perPage"] = arg2
+	var arg3 *int
+	if tmp, ok := rawArgs["first"]; ok {
+		ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("first"))
+		arg3, err = ec.unmarshalOInt2ᚖint(ctx, tmp)
+		if err != nil {
+			return nil, err
+		}
+	}
+	args["first"] = arg3
+	var arg4 *string
+	if tmp, ok := rawArgs["after"]; ok {
+		ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("after"))
+		arg4, err = ec.unmarshalOString2ᚖstring(ctx, tmp)
+		if err != nil {
+			return nil, err
+		}
+	}
+	args["after"] = arg4
+	var arg5 *int
+	if tmp, ok := rawArgs["last"]; ok {
+		ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("last"))
+		arg5, err = ec.unmarshalOInt2ᚖint(ctx, tmp)
+		if err != nil {
+			return nil, err
+		}
+	}
+	args["last"] = arg5
+	var arg6 *string
+	if tmp, ok := rawArgs["before"]; ok {
+		ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("before"))
+		arg6, err = ec.unmarshalOString2ᚖstring(ctx, tmp)
+		if err != nil {
+			return nil, err
+		}
+	}
+	args["before"] = arg6
+	return args, nil
+}
+
+func (ec *executionContext) field_Query_queryAppByAddress_args(ctx context.Context, rawArgs map[string]interface{}) (map[string]interface{}, error) {
+	var err error
+	args := map[string]interface{}{}
+	var arg0 string
 	if tmp, ok := rawArgs["address"]; ok {
 		ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("address"))
 		arg0, err = ec.unmarshalNString2string(ctx, tmp)
@@ -1286,6 +2024,42 @@ func (ec *executionContext) field_Query_queryApps_args(ctx context.Context, rawA
 		}
 	}
 	args["perPage"] = arg2
+	var arg3 *int
+	if tmp, ok := rawArgs["first"]; ok {
+		ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("first"))
+		arg3, err = ec.unmarshalOInt2ᚖint(ctx, tmp)
+		if err != nil {
+			return nil, err
+		}
+	}
+	args["first"] = arg3
+	var arg4 *string
+	if tmp, ok := rawArgs["after"]; ok {
+		ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("after"))
+		arg4, err = ec.unmarshalOString2ᚖstring(ctx, tmp)
+		if err != nil {
+			return nil, err
+		}
+	}
+	args["after"] = arg4
+	var arg5 *int
+	if tmp, ok := rawArgs["last"]; ok {
+		ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("last"))
+		arg5, err = ec.unmarshalOInt2ᚖint(ctx, tmp)
+		if err != nil {
+			return nil, err
+		}
+	}
+	args["last"] = arg5
+	var arg6 *string
+	if tmp, ok := rawArgs["before"]; ok {
+		ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("before"))
+		arg6, err = ec.unmarshalOString2ᚖstring(ctx, tmp)
+		if err != nil {
+			return nil, err
+		}
+	}
+	args["before"] = arg6
 	return args, nil
 }
 
@@ -1349,6 +2123,42 @@ func (ec *executionContext) field_Query_queryBlocks_args(ctx context.Context, ra
 		}
 	}
 	args["order"] = arg2
+	var arg3 *int
+	if tmp, ok := rawArgs["first"]; ok {
+		ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("first"))
+		arg3, err = ec.unmarshalOInt2ᚖint(ctx, tmp)
+		if err != nil {
+			return nil, err
+		}
+	}
+	args["first"] = arg3
+	var arg4 *string
+	if tmp, ok := rawArgs["after"]; ok {
+		ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("after"))
+		arg4, err = ec.unmarshalOString2ᚖstring(ctx, tmp)
+		if err != nil {
+			return nil, err
+		}
+	}
+	args["after"] = arg4
+	var arg5 *int
+	if tmp, ok := rawArgs["last"]; ok {
+		ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("last"))
+		arg5, err = ec.unmarshalOInt2ᚖint(ctx, tmp)
+		if err != nil {
+			return nil, err
+		}
+	}
+	args["last"] = arg5
+	var arg6 *string
+	if tmp, ok := rawArgs["before"]; ok {
+		ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("before"))
+		arg6, err = ec.unmarshalOString2ᚖstring(ctx, tmp)
+		if err != nil {
+			return nil, err
+		}
+	}
+	args["before"] = arg6
 	return args, nil
 }
 
@@ -1406,6 +2216,42 @@ func (ec *executionContext) field_Query_queryNodes_args(ctx context.Context, raw
 		}
 	}
 	args["perPage"] = arg2
+	var arg3 *int
+	if tmp, ok := rawArgs["first"]; ok {
+		ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("first"))
+		arg3, err = ec.unmarshalOInt2ᚖint(ctx, tmp)
+		if err != nil {
+			return nil, err
+		}
+	}
+	args["first"] = arg3
+	var arg4 *string
+	if tmp, ok := rawArgs["after"]; ok {
+		ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("after"))
+		arg4, err = ec.unmarshalOString2ᚖstring(ctx, tmp)
+		if err != nil {
+			return nil, err
+		}
+	}
+	args["after"] = arg4
+	var arg5 *int
+	if tmp, ok := rawArgs["last"]; ok {
+		ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("last"))
+		arg5, err = ec.unmarshalOInt2ᚖint(ctx, tmp)
+		if err != nil {
+			return nil, err
+		}
+	}
+	args["last"] = arg5
+	var arg6 *string
+	if tmp, ok := rawArgs["before"]; ok {
+		ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("before"))
+		arg6, err = ec.unmarshalOString2ᚖstring(ctx, tmp)
+		if err != nil {
+			return nil, err
+		}
+	}
+	args["before"] = arg6
 	return args, nil
 }
 
@@ -1454,22 +2300,76 @@ func (ec *executionContext) field_Query_queryTransactionsByAddress_args(ctx cont
 		}
 	}
 	args["perPage"] = arg2
-	return args, nil
-}
-
-func (ec *executionContext) field_Query_queryTransactionsByHeight_args(ctx context.Context, rawArgs map[string]interface{}) (map[string]interface{}, error) {
-	var err error
-	args := map[string]interface{}{}
-	var arg0 int
-	if tmp, ok := rawArgs["height"]; ok {
-		ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("height"))
-		arg0, err = ec.unmarshalNInt2int(ctx, tmp)
+	var arg3 *postgresdriver.Order
+	if tmp, ok := rawArgs["order"]; ok {
+		ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("order"))
+		arg3, err = ec.unmarshalOOrder2ᚖgithubᚗcomᚋpoktᚑfoundationᚋpocketᚑindexerᚑlibᚋpostgresᚑdriverᚐOrder(ctx, tmp)
 		if err != nil {
 			return nil, err
 		}
 	}
-	args["height"] = arg0
-	var arg1 *int
+	args["order"] = arg3
+	var arg4 *model.TransactionFilter
+	if tmp, ok := rawArgs["filter"]; ok {
+		ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("filter"))
+		arg4, err = ec.unmarshalOTransactionFilter2ᚖgithubᚗcomᚋpoktᚑfoundationᚋpocketᚑindexerᚑservicesᚋapiᚋgraphᚋmodelᚐTransactionFilter(ctx, tmp)
+		if err != nil {
+			return nil, err
+		}
+	}
+	args["filter"] = arg4
+	var arg5 *int
+	if tmp, ok := rawArgs["first"]; ok {
+		ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("first"))
+		arg5, err = ec.unmarshalOInt2ᚖint(ctx, tmp)
+		if err != nil {
+			return nil, err
+		}
+	}
+	args["first"] = arg5
+	var arg6 *string
+	if tmp, ok := rawArgs["after"]; ok {
+		ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("after"))
+		arg6, err = ec.unmarshalOString2ᚖstring(ctx, tmp)
+		if err != nil {
+			return nil, err
+		}
+	}
+	args["after"] = arg6
+	var arg7 *int
+	if tmp, ok := rawArgs["last"]; ok {
+		ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("last"))
+		arg7, err = ec.unmarshalOInt2ᚖint(ctx, tmp)
+		if err != nil {
+			return nil, err
+		}
+	}
+	args["last"] = arg7
+	var arg8 *string
+	if tmp, ok := rawArgs["before"]; ok {
+		ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("before"))
+		arg8, err = ec.unmarshalOString2ᚖstring(ctx, tmp)
+		if err != nil {
+			return nil, err
+		}
+	}
+	args["before"] = arg8
+	return args, nil
+}
+
+func (ec *executionContext) field_Query_queryTransactionsByHeight_args(ctx context.Context, rawArgs map[string]interface{}) (map[string]interface{}, error) {
+	var err error
+	args := map[string]interface{}{}
+	var arg0 int
+	if tmp, ok := rawArgs["height"]; ok {
+		ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("height"))
+		arg0, err = ec.unmarshalNInt2int(ctx, tmp)
+		if err != nil {
+			return nil, err
+		}
+	}
+	args["height"] = arg0
+	var arg1 *int
 	if tmp, ok := rawArgs["page"]; ok {
 		ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("page"))
 		arg1, err = ec.unmarshalOInt2ᚖint(ctx, tmp)
@@ -1487,6 +2387,42 @@ func (ec *executionContext) field_Query_queryTransactionsByHeight_args(ctx conte
 		}
 	}
 	args["perPage"] = arg2
+	var arg3 *int
+	if tmp, ok := rawArgs["first"]; ok {
+		ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("first"))
+		arg3, err = ec.unmarshalOInt2ᚖint(ctx, tmp)
+		if err != nil {
+			return nil, err
+		}
+	}
+	args["first"] = arg3
+	var arg4 *string
+	if tmp, ok := rawArgs["after"]; ok {
+		ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("after"))
+		arg4, err = ec.unmarshalOString2ᚖstring(ctx, tmp)
+		if err != nil {
+			return nil, err
+		}
+	}
+	args["after"] = arg4
+	var arg5 *int
+	if tmp, ok := rawArgs["last"]; ok {
+		ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("last"))
+		arg5, err = ec.unmarshalOInt2ᚖint(ctx, tmp)
+		if err != nil {
+			return nil, err
+		}
+	}
+	args["last"] = arg5
+	var arg6 *string
+	if tmp, ok := rawArgs["before"]; ok {
+		ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("before"))
+		arg6, err = ec.unmarshalOString2ᚖstring(ctx, tmp)
+		if err != nil {
+			return nil, err
+		}
+	}
+	args["before"] = arg6
 	return args, nil
 }
 
@@ -1520,6 +2456,51 @@ func (ec *executionContext) field_Query_queryTransactions_args(ctx context.Conte
 		}
 	}
 	args["order"] = arg2
+	var arg3 *model.TransactionFilter
+	if tmp, ok := rawArgs["filter"]; ok {
+		ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("filter"))
+		arg3, err = ec.unmarshalOTransactionFilter2ᚖgithubᚗcomᚋpoktᚑfoundationᚋpocketᚑindexerᚑservicesᚋapiᚋgraphᚋmodelᚐTransactionFilter(ctx, tmp)
+		if err != nil {
+			return nil, err
+		}
+	}
+	args["filter"] = arg3
+	var arg4 *int
+	if tmp, ok := rawArgs["first"]; ok {
+		ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("first"))
+		arg4, err = ec.unmarshalOInt2ᚖint(ctx, tmp)
+		if err != nil {
+			return nil, err
+		}
+	}
+	args["first"] = arg4
+	var arg5 *string
+	if tmp, ok := rawArgs["after"]; ok {
+		ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("after"))
+		arg5, err = ec.unmarshalOString2ᚖstring(ctx, tmp)
+		if err != nil {
+			return nil, err
+		}
+	}
+	args["after"] = arg5
+	var arg6 *int
+	if tmp, ok := rawArgs["last"]; ok {
+		ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("last"))
+		arg6, err = ec.unmarshalOInt2ᚖint(ctx, tmp)
+		if err != nil {
+			return nil, err
+		}
+	}
+	args["last"] = arg6
+	var arg7 *string
+	if tmp, ok := rawArgs["before"]; ok {
+		ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("before"))
+		arg7, err = ec.unmarshalOString2ᚖstring(ctx, tmp)
+		if err != nil {
+			return nil, err
+		}
+	}
+	args["before"] = arg7
 	return args, nil
 }
 
@@ -1534,35 +2515,1299 @@ func (ec *executionContext) field___Type_enumValues_args(ctx context.Context, ra
 			return nil, err
 		}
 	}
-	args["includeDeprecated"] = arg0
-	return args, nil
+	args["includeDeprecated"] = arg0
+	return args, nil
+}
+
+func (ec *executionContext) field___Type_fields_args(ctx context.Context, rawArgs map[string]interface{}) (map[string]interface{}, error) {
+	var err error
+	args := map[string]interface{}{}
+	var arg0 bool
+	if tmp, ok := rawArgs["includeDeprecated"]; ok {
+		ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("includeDeprecated"))
+		arg0, err = ec.unmarshalOBoolean2bool(ctx, tmp)
+		if err != nil {
+			return nil, err
+		}
+	}
+	args["includeDeprecated"] = arg0
+	return args, nil
+}
+
+// endregion ***************************** args.gotpl *****************************
+
+// region    ************************** directives.gotpl **************************
+
+// endregion ************************** directives.gotpl **************************
+
+// region    **************************** field.gotpl *****************************
+
+func (ec *executionContext) _AccountEdge_cursor(ctx context.Context, field graphql.CollectedField, obj *model.AccountEdge) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_AccountEdge_cursor(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Cursor, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(string)
+	fc.Result = res
+	return ec.marshalNString2string(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext_AccountEdge_cursor(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "AccountEdge",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type String does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _AccountEdge_node(ctx context.Context, field graphql.CollectedField, obj *model.AccountEdge) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_AccountEdge_node(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Node, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(*model.GraphQLAccount)
+	fc.Result = res
+	return ec.marshalNGraphQLAccount2ᚖgithubᚗcomᚋpoktᚑfoundationᚋpocketᚑindexerᚑservicesᚋapiᚋgraphᚋmodelᚐGraphQLAccount(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext_AccountEdge_node(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "AccountEdge",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			switch field.Name {
+			case "address":
+				return ec.fieldContext_GraphQLAccount_address(ctx, field)
+			case "height":
+				return ec.fieldContext_GraphQLAccount_height(ctx, field)
+			case "accountType":
+				return ec.fieldContext_GraphQLAccount_accountType(ctx, field)
+			case "balance":
+				return ec.fieldContext_GraphQLAccount_balance(ctx, field)
+			case "balanceDenomination":
+				return ec.fieldContext_GraphQLAccount_balanceDenomination(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type GraphQLAccount", field.Name)
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _AccountsResponse_accounts(ctx context.Context, field graphql.CollectedField, obj *model.AccountsResponse) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_AccountsResponse_accounts(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Accounts, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		return graphql.Null
+	}
+	res := resTmp.([]*model.GraphQLAccount)
+	fc.Result = res
+	return ec.marshalOGraphQLAccount2ᚕᚖgithubᚗcomᚋpoktᚑfoundationᚋpocketᚑindexerᚑservicesᚋapiᚋgraphᚋmodelᚐGraphQLAccount(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext_AccountsResponse_accounts(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "AccountsResponse",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			switch field.Name {
+			case "address":
+				return ec.fieldContext_GraphQLAccount_address(ctx, field)
+			case "height":
+				return ec.fieldContext_GraphQLAccount_height(ctx, field)
+			case "accountType":
+				return ec.fieldContext_GraphQLAccount_accountType(ctx, field)
+			case "balance":
+				return ec.fieldContext_GraphQLAccount_balance(ctx, field)
+			case "balanceDenomination":
+				return ec.fieldContext_GraphQLAccount_balanceDenomination(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type GraphQLAccount", field.Name)
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _AccountsResponse_totalCount(ctx context.Context, field graphql.CollectedField, obj *model.AccountsResponse) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_AccountsResponse_totalCount(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.TotalCount, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(int)
+	fc.Result = res
+	return ec.marshalNInt2int(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext_AccountsResponse_totalCount(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "AccountsResponse",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type Int does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _AccountsResponse_pageCount(ctx context.Context, field graphql.CollectedField, obj *model.AccountsResponse) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_AccountsResponse_pageCount(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.PageCount, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(int)
+	fc.Result = res
+	return ec.marshalNInt2int(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext_AccountsResponse_pageCount(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "AccountsResponse",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type Int does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _AccountsResponse_page(ctx context.Context, field graphql.CollectedField, obj *model.AccountsResponse) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_AccountsResponse_page(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Page, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(int)
+	fc.Result = res
+	return ec.marshalNInt2int(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext_AccountsResponse_page(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "AccountsResponse",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type Int does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _AccountsResponse_totalPages(ctx context.Context, field graphql.CollectedField, obj *model.AccountsResponse) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_AccountsResponse_totalPages(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.TotalPages, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(int)
+	fc.Result = res
+	return ec.marshalNInt2int(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext_AccountsResponse_totalPages(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "AccountsResponse",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type Int does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _AccountsResponse_edges(ctx context.Context, field graphql.CollectedField, obj *model.AccountsResponse) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_AccountsResponse_edges(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Edges, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.([]*model.AccountEdge)
+	fc.Result = res
+	return ec.marshalNAccountEdge2ᚕᚖgithubᚗcomᚋpoktᚑfoundationᚋpocketᚑindexerᚑservicesᚋapiᚋgraphᚋmodelᚐAccountEdgeᚄ(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext_AccountsResponse_edges(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "AccountsResponse",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			switch field.Name {
+			case "cursor":
+				return ec.fieldContext_AccountEdge_cursor(ctx, field)
+			case "node":
+				return ec.fieldContext_AccountEdge_node(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type AccountEdge", field.Name)
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _AccountsResponse_pageInfo(ctx context.Context, field graphql.CollectedField, obj *model.AccountsResponse) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_AccountsResponse_pageInfo(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.PageInfo, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(*model.PageInfo)
+	fc.Result = res
+	return ec.marshalNPageInfo2ᚖgithubᚗcomᚋpoktᚑfoundationᚋpocketᚑindexerᚑservicesᚋapiᚋgraphᚋmodelᚐPageInfo(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext_AccountsResponse_pageInfo(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "AccountsResponse",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			switch field.Name {
+			case "hasNextPage":
+				return ec.fieldContext_PageInfo_hasNextPage(ctx, field)
+			case "hasPreviousPage":
+				return ec.fieldContext_PageInfo_hasPreviousPage(ctx, field)
+			case "startCursor":
+				return ec.fieldContext_PageInfo_startCursor(ctx, field)
+			case "endCursor":
+				return ec.fieldContext_PageInfo_endCursor(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type PageInfo", field.Name)
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _AppEdge_cursor(ctx context.Context, field graphql.CollectedField, obj *model.AppEdge) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_AppEdge_cursor(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Cursor, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(string)
+	fc.Result = res
+	return ec.marshalNString2string(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext_AppEdge_cursor(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "AppEdge",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type String does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _AppEdge_node(ctx context.Context, field graphql.CollectedField, obj *model.AppEdge) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_AppEdge_node(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Node, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(*model.GraphQLApp)
+	fc.Result = res
+	return ec.marshalNGraphQLApp2ᚖgithubᚗcomᚋpoktᚑfoundationᚋpocketᚑindexerᚑservicesᚋapiᚋgraphᚋmodelᚐGraphQLApp(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext_AppEdge_node(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "AppEdge",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			switch field.Name {
+			case "address":
+				return ec.fieldContext_GraphQLApp_address(ctx, field)
+			case "height":
+				return ec.fieldContext_GraphQLApp_height(ctx, field)
+			case "jailed":
+				return ec.fieldContext_GraphQLApp_jailed(ctx, field)
+			case "publicKey":
+				return ec.fieldContext_GraphQLApp_publicKey(ctx, field)
+			case "stakedTokens":
+				return ec.fieldContext_GraphQLApp_stakedTokens(ctx, field)
+			case "account":
+				return ec.fieldContext_GraphQLApp_account(ctx, field)
+			case "transactions":
+				return ec.fieldContext_GraphQLApp_transactions(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type GraphQLApp", field.Name)
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _AppsResponse_apps(ctx context.Context, field graphql.CollectedField, obj *model.AppsResponse) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_AppsResponse_apps(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Apps, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		return graphql.Null
+	}
+	res := resTmp.([]*model.GraphQLApp)
+	fc.Result = res
+	return ec.marshalOGraphQLApp2ᚕᚖgithubᚗcomᚋpoktᚑfoundationᚋpocketᚑindexerᚑservicesᚋapiᚋgraphᚋmodelᚐGraphQLApp(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext_AppsResponse_apps(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "AppsResponse",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			switch field.Name {
+			case "address":
+				return ec.fieldContext_GraphQLApp_address(ctx, field)
+			case "height":
+				return ec.fieldContext_GraphQLApp_height(ctx, field)
+			case "jailed":
+				return ec.fieldContext_GraphQLApp_jailed(ctx, field)
+			case "publicKey":
+				return ec.fieldContext_GraphQLApp_publicKey(ctx, field)
+			case "stakedTokens":
+				return ec.fieldContext_GraphQLApp_stakedTokens(ctx, field)
+			case "account":
+				return ec.fieldContext_GraphQLApp_account(ctx, field)
+			case "transactions":
+				return ec.fieldContext_GraphQLApp_transactions(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type GraphQLApp", field.Name)
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _AppsResponse_totalCount(ctx context.Context, field graphql.CollectedField, obj *model.AppsResponse) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_AppsResponse_totalCount(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.TotalCount, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(int)
+	fc.Result = res
+	return ec.marshalNInt2int(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext_AppsResponse_totalCount(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "AppsResponse",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type Int does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _AppsResponse_pageCount(ctx context.Context, field graphql.CollectedField, obj *model.AppsResponse) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_AppsResponse_pageCount(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.PageCount, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(int)
+	fc.Result = res
+	return ec.marshalNInt2int(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext_AppsResponse_pageCount(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "AppsResponse",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type Int does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _AppsResponse_page(ctx context.Context, field graphql.CollectedField, obj *model.AppsResponse) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_AppsResponse_page(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Page, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(int)
+	fc.Result = res
+	return ec.marshalNInt2int(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext_AppsResponse_page(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "AppsResponse",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type Int does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _AppsResponse_totalPages(ctx context.Context, field graphql.CollectedField, obj *model.AppsResponse) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_AppsResponse_totalPages(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.TotalPages, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(int)
+	fc.Result = res
+	return ec.marshalNInt2int(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext_AppsResponse_totalPages(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "AppsResponse",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type Int does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _AppsResponse_edges(ctx context.Context, field graphql.CollectedField, obj *model.AppsResponse) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_AppsResponse_edges(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Edges, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.([]*model.AppEdge)
+	fc.Result = res
+	return ec.marshalNAppEdge2ᚕᚖgithubᚗcomᚋpoktᚑfoundationᚋpocketᚑindexerᚑservicesᚋapiᚋgraphᚋmodelᚐAppEdgeᚄ(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext_AppsResponse_edges(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "AppsResponse",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			switch field.Name {
+			case "cursor":
+				return ec.fieldContext_AppEdge_cursor(ctx, field)
+			case "node":
+				return ec.fieldContext_AppEdge_node(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type AppEdge", field.Name)
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _AppsResponse_pageInfo(ctx context.Context, field graphql.CollectedField, obj *model.AppsResponse) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_AppsResponse_pageInfo(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.PageInfo, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(*model.PageInfo)
+	fc.Result = res
+	return ec.marshalNPageInfo2ᚖgithubᚗcomᚋpoktᚑfoundationᚋpocketᚑindexerᚑservicesᚋapiᚋgraphᚋmodelᚐPageInfo(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext_AppsResponse_pageInfo(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "AppsResponse",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			switch field.Name {
+			case "hasNextPage":
+				return ec.fieldContext_PageInfo_hasNextPage(ctx, field)
+			case "hasPreviousPage":
+				return ec.fieldContext_PageInfo_hasPreviousPage(ctx, field)
+			case "startCursor":
+				return ec.fieldContext_PageInfo_startCursor(ctx, field)
+			case "endCursor":
+				return ec.fieldContext_PageInfo_endCursor(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type PageInfo", field.Name)
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _Block_hash(ctx context.Context, field graphql.CollectedField, obj *indexer.Block) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_Block_hash(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Hash, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(string)
+	fc.Result = res
+	return ec.marshalNString2string(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext_Block_hash(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "Block",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type String does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _Block_height(ctx context.Context, field graphql.CollectedField, obj *indexer.Block) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_Block_height(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Height, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(int)
+	fc.Result = res
+	return ec.marshalNInt2int(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext_Block_height(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "Block",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type Int does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _Block_time(ctx context.Context, field graphql.CollectedField, obj *indexer.Block) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_Block_time(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Time, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(time.Time)
+	fc.Result = res
+	return ec.marshalNTime2timeᚐTime(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext_Block_time(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "Block",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type Time does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _Block_proposerAddress(ctx context.Context, field graphql.CollectedField, obj *indexer.Block) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_Block_proposerAddress(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.ProposerAddress, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(string)
+	fc.Result = res
+	return ec.marshalNString2string(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext_Block_proposerAddress(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "Block",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type String does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _Block_txCount(ctx context.Context, field graphql.CollectedField, obj *indexer.Block) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_Block_txCount(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.TXCount, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(int)
+	fc.Result = res
+	return ec.marshalNInt2int(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext_Block_txCount(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "Block",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type Int does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _Block_transactions(ctx context.Context, field graphql.CollectedField, obj *indexer.Block) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_Block_transactions(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return ec.resolvers.Block().Transactions(rctx, obj, fc.Args["page"].(*int), fc.Args["perPage"].(*int), fc.Args["filter"].(*model.TransactionFilter), fc.Args["first"].(*int), fc.Args["after"].(*string), fc.Args["last"].(*int), fc.Args["before"].(*string))
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		return graphql.Null
+	}
+	res := resTmp.(*model.TransactionsResponse)
+	fc.Result = res
+	return ec.marshalOTransactionsResponse2ᚖgithubᚗcomᚋpoktᚑfoundationᚋpocketᚑindexerᚑservicesᚋapiᚋgraphᚋmodelᚐTransactionsResponse(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext_Block_transactions(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "Block",
+		Field:      field,
+		IsMethod:   true,
+		IsResolver: true,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			switch field.Name {
+			case "transactions":
+				return ec.fieldContext_TransactionsResponse_transactions(ctx, field)
+			case "totalCount":
+				return ec.fieldContext_TransactionsResponse_totalCount(ctx, field)
+			case "pageCount":
+				return ec.fieldContext_TransactionsResponse_pageCount(ctx, field)
+			case "page":
+				return ec.fieldContext_TransactionsResponse_page(ctx, field)
+			case "totalPages":
+				return ec.fieldContext_TransactionsResponse_totalPages(ctx, field)
+			case "edges":
+				return ec.fieldContext_TransactionsResponse_edges(ctx, field)
+			case "pageInfo":
+				return ec.fieldContext_TransactionsResponse_pageInfo(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type TransactionsResponse", field.Name)
+		},
+	}
+	defer func() {
+		if r := recover(); r != nil {
+			err = ec.Recover(ctx, r)
+			ec.Error(ctx, err)
+		}
+	}()
+	ctx = graphql.WithFieldContext(ctx, fc)
+	if fc.Args, err = ec.field_Block_transactions_args(ctx, field.ArgumentMap(ec.Variables)); err != nil {
+		ec.Error(ctx, err)
+		return
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _BlockEdge_cursor(ctx context.Context, field graphql.CollectedField, obj *model.BlockEdge) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_BlockEdge_cursor(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Cursor, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(string)
+	fc.Result = res
+	return ec.marshalNString2string(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext_BlockEdge_cursor(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "BlockEdge",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type String does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _BlockEdge_node(ctx context.Context, field graphql.CollectedField, obj *model.BlockEdge) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_BlockEdge_node(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Node, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(*indexer.Block)
+	fc.Result = res
+	return ec.marshalNBlock2ᚖgithubᚗcomᚋpoktᚑfoundationᚋpocketᚑindexerᚑlibᚐBlock(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) field___Type_fields_args(ctx context.Context, rawArgs map[string]interface{}) (map[string]interface{}, error) {
-	var err error
-	args := map[string]interface{}{}
-	var arg0 bool
-	if tmp, ok := rawArgs["includeDeprecated"]; ok {
-		ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("includeDeprecated"))
-		arg0, err = ec.unmarshalOBoolean2bool(ctx, tmp)
-		if err != nil {
-			return nil, err
-		}
+func (ec *executionContext) fieldContext_BlockEdge_node(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "BlockEdge",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			switch field.Name {
+			case "hash":
+				return ec.fieldContext_Block_hash(ctx, field)
+			case "height":
+				return ec.fieldContext_Block_height(ctx, field)
+			case "time":
+				return ec.fieldContext_Block_time(ctx, field)
+			case "proposerAddress":
+				return ec.fieldContext_Block_proposerAddress(ctx, field)
+			case "txCount":
+				return ec.fieldContext_Block_txCount(ctx, field)
+			case "transactions":
+				return ec.fieldContext_Block_transactions(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type Block", field.Name)
+		},
 	}
-	args["includeDeprecated"] = arg0
-	return args, nil
+	return fc, nil
 }
 
-// endregion ***************************** args.gotpl *****************************
-
-// region    ************************** directives.gotpl **************************
-
-// endregion ************************** directives.gotpl **************************
-
-// region    **************************** field.gotpl *****************************
-
-func (ec *executionContext) _AccountsResponse_accounts(ctx context.Context, field graphql.CollectedField, obj *model.AccountsResponse) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_AccountsResponse_accounts(ctx, field)
+func (ec *executionContext) _BlocksResponse_blocks(ctx context.Context, field graphql.CollectedField, obj *model.BlocksResponse) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_BlocksResponse_blocks(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -1575,7 +3820,7 @@ func (ec *executionContext) _AccountsResponse_accounts(ctx context.Context, fiel
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.Accounts, nil
+		return obj.Blocks, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -1584,38 +3829,40 @@ func (ec *executionContext) _AccountsResponse_accounts(ctx context.Context, fiel
 	if resTmp == nil {
 		return graphql.Null
 	}
-	res := resTmp.([]*model.GraphQLAccount)
+	res := resTmp.([]*indexer.Block)
 	fc.Result = res
-	return ec.marshalOGraphQLAccount2ᚕᚖgithubᚗcomᚋpoktᚑfoundationᚋpocketᚑindexerᚑservicesᚋapiᚋgraphᚋmodelᚐGraphQLAccount(ctx, field.Selections, res)
+	return ec.marshalOBlock2ᚕᚖgithubᚗcomᚋpoktᚑfoundationᚋpocketᚑindexerᚑlibᚐBlock(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_AccountsResponse_accounts(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_BlocksResponse_blocks(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "AccountsResponse",
+		Object:     "BlocksResponse",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
 			switch field.Name {
-			case "address":
-				return ec.fieldContext_GraphQLAccount_address(ctx, field)
+			case "hash":
+				return ec.fieldContext_Block_hash(ctx, field)
 			case "height":
-				return ec.fieldContext_GraphQLAccount_height(ctx, field)
-			case "accountType":
-				return ec.fieldContext_GraphQLAccount_accountType(ctx, field)
-			case "balance":
-				return ec.fieldContext_GraphQLAccount_balance(ctx, field)
-			case "balanceDenomination":
-				return ec.fieldContext_GraphQLAccount_balanceDenomination(ctx, field)
+				return ec.fieldContext_Block_height(ctx, field)
+			case "time":
+				return ec.fieldContext_Block_time(ctx, field)
+			case "proposerAddress":
+				return ec.fieldContext_Block_proposerAddress(ctx, field)
+			case "txCount":
+				return ec.fieldContext_Block_txCount(ctx, field)
+			case "transactions":
+				return ec.fieldContext_Block_transactions(ctx, field)
 			}
-			return nil, fmt.Errorf("no field named %q was found under type GraphQLAccount", field.Name)
+			return nil, fmt.Errorf("no field named %q was found under type Block", field.Name)
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _AccountsResponse_totalCount(ctx context.Context, field graphql.CollectedField, obj *model.AccountsResponse) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_AccountsResponse_totalCount(ctx, field)
+func (ec *executionContext) _BlocksResponse_totalCount(ctx context.Context, field graphql.CollectedField, obj *model.BlocksResponse) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_BlocksResponse_totalCount(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -1645,9 +3892,9 @@ func (ec *executionContext) _AccountsResponse_totalCount(ctx context.Context, fi
 	return ec.marshalNInt2int(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_AccountsResponse_totalCount(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_BlocksResponse_totalCount(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "AccountsResponse",
+		Object:     "BlocksResponse",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
@@ -1658,8 +3905,8 @@ func (ec *executionContext) fieldContext_AccountsResponse_totalCount(ctx context
 	return fc, nil
 }
 
-func (ec *executionContext) _AccountsResponse_pageCount(ctx context.Context, field graphql.CollectedField, obj *model.AccountsResponse) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_AccountsResponse_pageCount(ctx, field)
+func (ec *executionContext) _BlocksResponse_pageCount(ctx context.Context, field graphql.CollectedField, obj *model.BlocksResponse) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_BlocksResponse_pageCount(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -1689,9 +3936,9 @@ func (ec *executionContext) _AccountsResponse_pageCount(ctx context.Context, fie
 	return ec.marshalNInt2int(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_AccountsResponse_pageCount(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_BlocksResponse_pageCount(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "AccountsResponse",
+		Object:     "BlocksResponse",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
@@ -1702,8 +3949,8 @@ func (ec *executionContext) fieldContext_AccountsResponse_pageCount(ctx context.
 	return fc, nil
 }
 
-func (ec *executionContext) _AccountsResponse_page(ctx context.Context, field graphql.CollectedField, obj *model.AccountsResponse) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_AccountsResponse_page(ctx, field)
+func (ec *executionContext) _BlocksResponse_page(ctx context.Context, field graphql.CollectedField, obj *model.BlocksResponse) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_BlocksResponse_page(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -1733,9 +3980,9 @@ func (ec *executionContext) _AccountsResponse_page(ctx context.Context, field gr
 	return ec.marshalNInt2int(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_AccountsResponse_page(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_BlocksResponse_page(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "AccountsResponse",
+		Object:     "BlocksResponse",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
@@ -1746,8 +3993,8 @@ func (ec *executionContext) fieldContext_AccountsResponse_page(ctx context.Conte
 	return fc, nil
 }
 
-func (ec *executionContext) _AccountsResponse_totalPages(ctx context.Context, field graphql.CollectedField, obj *model.AccountsResponse) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_AccountsResponse_totalPages(ctx, field)
+func (ec *executionContext) _BlocksResponse_totalPages(ctx context.Context, field graphql.CollectedField, obj *model.BlocksResponse) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_BlocksResponse_totalPages(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -1777,9 +4024,9 @@ func (ec *executionContext) _AccountsResponse_totalPages(ctx context.Context, fi
 	return ec.marshalNInt2int(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_AccountsResponse_totalPages(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_BlocksResponse_totalPages(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "AccountsResponse",
+		Object:     "BlocksResponse",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
@@ -1790,8 +4037,8 @@ func (ec *executionContext) fieldContext_AccountsResponse_totalPages(ctx context
 	return fc, nil
 }
 
-func (ec *executionContext) _AppsResponse_apps(ctx context.Context, field graphql.CollectedField, obj *model.AppsResponse) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_AppsResponse_apps(ctx, field)
+func (ec *executionContext) _BlocksResponse_edges(ctx context.Context, field graphql.CollectedField, obj *model.BlocksResponse) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_BlocksResponse_edges(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -1804,47 +4051,98 @@ func (ec *executionContext) _AppsResponse_apps(ctx context.Context, field graphq
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.Apps, nil
+		return obj.Edges, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.([]*model.BlockEdge)
+	fc.Result = res
+	return ec.marshalNBlockEdge2ᚕᚖgithubᚗcomᚋpoktᚑfoundationᚋpocketᚑindexerᚑservicesᚋapiᚋgraphᚋmodelᚐBlockEdgeᚄ(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext_BlocksResponse_edges(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "BlocksResponse",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			switch field.Name {
+			case "cursor":
+				return ec.fieldContext_BlockEdge_cursor(ctx, field)
+			case "node":
+				return ec.fieldContext_BlockEdge_node(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type BlockEdge", field.Name)
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _BlocksResponse_pageInfo(ctx context.Context, field graphql.CollectedField, obj *model.BlocksResponse) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_BlocksResponse_pageInfo(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.PageInfo, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
 		return graphql.Null
 	}
 	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
 		return graphql.Null
 	}
-	res := resTmp.([]*model.GraphQLApp)
+	res := resTmp.(*model.PageInfo)
 	fc.Result = res
-	return ec.marshalOGraphQLApp2ᚕᚖgithubᚗcomᚋpoktᚑfoundationᚋpocketᚑindexerᚑservicesᚋapiᚋgraphᚋmodelᚐGraphQLApp(ctx, field.Selections, res)
+	return ec.marshalNPageInfo2ᚖgithubᚗcomᚋpoktᚑfoundationᚋpocketᚑindexerᚑservicesᚋapiᚋgraphᚋmodelᚐPageInfo(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_AppsResponse_apps(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_BlocksResponse_pageInfo(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "AppsResponse",
+		Object:     "BlocksResponse",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
 			switch field.Name {
-			case "address":
-				return ec.fieldContext_GraphQLApp_address(ctx, field)
-			case "height":
-				return ec.fieldContext_GraphQLApp_height(ctx, field)
-			case "jailed":
-				return ec.fieldContext_GraphQLApp_jailed(ctx, field)
-			case "publicKey":
-				return ec.fieldContext_GraphQLApp_publicKey(ctx, field)
-			case "stakedTokens":
-				return ec.fieldContext_GraphQLApp_stakedTokens(ctx, field)
+			case "hasNextPage":
+				return ec.fieldContext_PageInfo_hasNextPage(ctx, field)
+			case "hasPreviousPage":
+				return ec.fieldContext_PageInfo_hasPreviousPage(ctx, field)
+			case "startCursor":
+				return ec.fieldContext_PageInfo_startCursor(ctx, field)
+			case "endCursor":
+				return ec.fieldContext_PageInfo_endCursor(ctx, field)
 			}
-			return nil, fmt.Errorf("no field named %q was found under type GraphQLApp", field.Name)
+			return nil, fmt.Errorf("no field named %q was found under type PageInfo", field.Name)
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _AppsResponse_totalCount(ctx context.Context, field graphql.CollectedField, obj *model.AppsResponse) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_AppsResponse_totalCount(ctx, field)
+func (ec *executionContext) _Fee_amount(ctx context.Context, field graphql.CollectedField, obj *provider.Fee) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_Fee_amount(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -1857,7 +4155,7 @@ func (ec *executionContext) _AppsResponse_totalCount(ctx context.Context, field
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.TotalCount, nil
+		return obj.Amount, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -1869,26 +4167,26 @@ func (ec *executionContext) _AppsResponse_totalCount(ctx context.Context, field
 		}
 		return graphql.Null
 	}
-	res := resTmp.(int)
+	res := resTmp.(string)
 	fc.Result = res
-	return ec.marshalNInt2int(ctx, field.Selections, res)
+	return ec.marshalNString2string(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_AppsResponse_totalCount(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_Fee_amount(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "AppsResponse",
+		Object:     "Fee",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type Int does not have child fields")
+			return nil, errors.New("field of type String does not have child fields")
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _AppsResponse_pageCount(ctx context.Context, field graphql.CollectedField, obj *model.AppsResponse) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_AppsResponse_pageCount(ctx, field)
+func (ec *executionContext) _Fee_denom(ctx context.Context, field graphql.CollectedField, obj *provider.Fee) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_Fee_denom(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -1901,7 +4199,7 @@ func (ec *executionContext) _AppsResponse_pageCount(ctx context.Context, field g
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.PageCount, nil
+		return obj.Denom, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -1913,26 +4211,70 @@ func (ec *executionContext) _AppsResponse_pageCount(ctx context.Context, field g
 		}
 		return graphql.Null
 	}
-	res := resTmp.(int)
+	res := resTmp.(string)
 	fc.Result = res
-	return ec.marshalNInt2int(ctx, field.Selections, res)
+	return ec.marshalNString2string(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_AppsResponse_pageCount(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_Fee_denom(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "AppsResponse",
+		Object:     "Fee",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type String does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _GraphQLAccount_address(ctx context.Context, field graphql.CollectedField, obj *model.GraphQLAccount) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_GraphQLAccount_address(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Address, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(string)
+	fc.Result = res
+	return ec.marshalNString2string(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext_GraphQLAccount_address(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "GraphQLAccount",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type Int does not have child fields")
+			return nil, errors.New("field of type String does not have child fields")
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _AppsResponse_page(ctx context.Context, field graphql.CollectedField, obj *model.AppsResponse) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_AppsResponse_page(ctx, field)
+func (ec *executionContext) _GraphQLAccount_height(ctx context.Context, field graphql.CollectedField, obj *model.GraphQLAccount) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_GraphQLAccount_height(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -1945,7 +4287,7 @@ func (ec *executionContext) _AppsResponse_page(ctx context.Context, field graphq
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.Page, nil
+		return obj.Height, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -1962,9 +4304,9 @@ func (ec *executionContext) _AppsResponse_page(ctx context.Context, field graphq
 	return ec.marshalNInt2int(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_AppsResponse_page(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_GraphQLAccount_height(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "AppsResponse",
+		Object:     "GraphQLAccount",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
@@ -1975,8 +4317,8 @@ func (ec *executionContext) fieldContext_AppsResponse_page(ctx context.Context,
 	return fc, nil
 }
 
-func (ec *executionContext) _AppsResponse_totalPages(ctx context.Context, field graphql.CollectedField, obj *model.AppsResponse) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_AppsResponse_totalPages(ctx, field)
+func (ec *executionContext) _GraphQLAccount_accountType(ctx context.Context, field graphql.CollectedField, obj *model.GraphQLAccount) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_GraphQLAccount_accountType(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -1989,7 +4331,7 @@ func (ec *executionContext) _AppsResponse_totalPages(ctx context.Context, field
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.TotalPages, nil
+		return obj.AccountType, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -2001,26 +4343,26 @@ func (ec *executionContext) _AppsResponse_totalPages(ctx context.Context, field
 		}
 		return graphql.Null
 	}
-	res := resTmp.(int)
+	res := resTmp.(string)
 	fc.Result = res
-	return ec.marshalNInt2int(ctx, field.Selections, res)
+	return ec.marshalNString2string(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_AppsResponse_totalPages(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_GraphQLAccount_accountType(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "AppsResponse",
+		Object:     "GraphQLAccount",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type Int does not have child fields")
+			return nil, errors.New("field of type String does not have child fields")
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _Block_hash(ctx context.Context, field graphql.CollectedField, obj *indexer.Block) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_Block_hash(ctx, field)
+func (ec *executionContext) _GraphQLAccount_balance(ctx context.Context, field graphql.CollectedField, obj *model.GraphQLAccount) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_GraphQLAccount_balance(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -2033,7 +4375,7 @@ func (ec *executionContext) _Block_hash(ctx context.Context, field graphql.Colle
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.Hash, nil
+		return obj.Balance, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -2050,9 +4392,9 @@ func (ec *executionContext) _Block_hash(ctx context.Context, field graphql.Colle
 	return ec.marshalNString2string(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_Block_hash(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_GraphQLAccount_balance(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "Block",
+		Object:     "GraphQLAccount",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
@@ -2063,8 +4405,8 @@ func (ec *executionContext) fieldContext_Block_hash(ctx context.Context, field g
 	return fc, nil
 }
 
-func (ec *executionContext) _Block_height(ctx context.Context, field graphql.CollectedField, obj *indexer.Block) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_Block_height(ctx, field)
+func (ec *executionContext) _GraphQLAccount_balanceDenomination(ctx context.Context, field graphql.CollectedField, obj *model.GraphQLAccount) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_GraphQLAccount_balanceDenomination(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -2077,7 +4419,7 @@ func (ec *executionContext) _Block_height(ctx context.Context, field graphql.Col
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.Height, nil
+		return obj.BalanceDenomination, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -2089,26 +4431,26 @@ func (ec *executionContext) _Block_height(ctx context.Context, field graphql.Col
 		}
 		return graphql.Null
 	}
-	res := resTmp.(int)
+	res := resTmp.(string)
 	fc.Result = res
-	return ec.marshalNInt2int(ctx, field.Selections, res)
+	return ec.marshalNString2string(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_Block_height(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_GraphQLAccount_balanceDenomination(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "Block",
+		Object:     "GraphQLAccount",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type Int does not have child fields")
+			return nil, errors.New("field of type String does not have child fields")
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _Block_time(ctx context.Context, field graphql.CollectedField, obj *indexer.Block) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_Block_time(ctx, field)
+func (ec *executionContext) _GraphQLApp_address(ctx context.Context, field graphql.CollectedField, obj *model.GraphQLApp) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_GraphQLApp_address(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -2121,7 +4463,7 @@ func (ec *executionContext) _Block_time(ctx context.Context, field graphql.Colle
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.Time, nil
+		return obj.Address, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -2133,26 +4475,26 @@ func (ec *executionContext) _Block_time(ctx context.Context, field graphql.Colle
 		}
 		return graphql.Null
 	}
-	res := resTmp.(time.Time)
+	res := resTmp.(string)
 	fc.Result = res
-	return ec.marshalNTime2timeᚐTime(ctx, field.Selections, res)
+	return ec.marshalNString2string(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_Block_time(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_GraphQLApp_address(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "Block",
+		Object:     "GraphQLApp",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type Time does not have child fields")
+			return nil, errors.New("field of type String does not have child fields")
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _Block_proposerAddress(ctx context.Context, field graphql.CollectedField, obj *indexer.Block) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_Block_proposerAddress(ctx, field)
+func (ec *executionContext) _GraphQLApp_height(ctx context.Context, field graphql.CollectedField, obj *model.GraphQLApp) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_GraphQLApp_height(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -2165,7 +4507,7 @@ func (ec *executionContext) _Block_proposerAddress(ctx context.Context, field gr
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.ProposerAddress, nil
+		return obj.Height, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -2177,26 +4519,26 @@ func (ec *executionContext) _Block_proposerAddress(ctx context.Context, field gr
 		}
 		return graphql.Null
 	}
-	res := resTmp.(string)
+	res := resTmp.(int)
 	fc.Result = res
-	return ec.marshalNString2string(ctx, field.Selections, res)
+	return ec.marshalNInt2int(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_Block_proposerAddress(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_GraphQLApp_height(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "Block",
+		Object:     "GraphQLApp",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type String does not have child fields")
+			return nil, errors.New("field of type Int does not have child fields")
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _Block_txCount(ctx context.Context, field graphql.CollectedField, obj *indexer.Block) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_Block_txCount(ctx, field)
+func (ec *executionContext) _GraphQLApp_jailed(ctx context.Context, field graphql.CollectedField, obj *model.GraphQLApp) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_GraphQLApp_jailed(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -2209,7 +4551,7 @@ func (ec *executionContext) _Block_txCount(ctx context.Context, field graphql.Co
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.TXCount, nil
+		return obj.Jailed, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -2221,26 +4563,26 @@ func (ec *executionContext) _Block_txCount(ctx context.Context, field graphql.Co
 		}
 		return graphql.Null
 	}
-	res := resTmp.(int)
+	res := resTmp.(bool)
 	fc.Result = res
-	return ec.marshalNInt2int(ctx, field.Selections, res)
+	return ec.marshalNBoolean2bool(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_Block_txCount(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_GraphQLApp_jailed(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "Block",
+		Object:     "GraphQLApp",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type Int does not have child fields")
+			return nil, errors.New("field of type Boolean does not have child fields")
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _BlocksResponse_blocks(ctx context.Context, field graphql.CollectedField, obj *model.BlocksResponse) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_BlocksResponse_blocks(ctx, field)
+func (ec *executionContext) _GraphQLApp_publicKey(ctx context.Context, field graphql.CollectedField, obj *model.GraphQLApp) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_GraphQLApp_publicKey(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -2253,47 +4595,38 @@ func (ec *executionContext) _BlocksResponse_blocks(ctx context.Context, field gr
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.Blocks, nil
+		return obj.PublicKey, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
 		return graphql.Null
 	}
 	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
 		return graphql.Null
 	}
-	res := resTmp.([]*indexer.Block)
+	res := resTmp.(string)
 	fc.Result = res
-	return ec.marshalOBlock2ᚕᚖgithubᚗcomᚋpoktᚑfoundationᚋpocketᚑindexerᚑlibᚐBlock(ctx, field.Selections, res)
+	return ec.marshalNString2string(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_BlocksResponse_blocks(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_GraphQLApp_publicKey(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "BlocksResponse",
+		Object:     "GraphQLApp",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			switch field.Name {
-			case "hash":
-				return ec.fieldContext_Block_hash(ctx, field)
-			case "height":
-				return ec.fieldContext_Block_height(ctx, field)
-			case "time":
-				return ec.fieldContext_Block_time(ctx, field)
-			case "proposerAddress":
-				return ec.fieldContext_Block_proposerAddress(ctx, field)
-			case "txCount":
-				return ec.fieldContext_Block_txCount(ctx, field)
-			}
-			return nil, fmt.Errorf("no field named %q was found under type Block", field.Name)
+			return nil, errors.New("field of type String does not have child fields")
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _BlocksResponse_totalCount(ctx context.Context, field graphql.CollectedField, obj *model.BlocksResponse) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_BlocksResponse_totalCount(ctx, field)
+func (ec *executionContext) _GraphQLApp_stakedTokens(ctx context.Context, field graphql.CollectedField, obj *model.GraphQLApp) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_GraphQLApp_stakedTokens(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -2306,7 +4639,7 @@ func (ec *executionContext) _BlocksResponse_totalCount(ctx context.Context, fiel
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.TotalCount, nil
+		return obj.StakedTokens, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -2318,26 +4651,26 @@ func (ec *executionContext) _BlocksResponse_totalCount(ctx context.Context, fiel
 		}
 		return graphql.Null
 	}
-	res := resTmp.(int)
+	res := resTmp.(string)
 	fc.Result = res
-	return ec.marshalNInt2int(ctx, field.Selections, res)
+	return ec.marshalNString2string(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_BlocksResponse_totalCount(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_GraphQLApp_stakedTokens(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "BlocksResponse",
+		Object:     "GraphQLApp",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type Int does not have child fields")
+			return nil, errors.New("field of type String does not have child fields")
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _BlocksResponse_pageCount(ctx context.Context, field graphql.CollectedField, obj *model.BlocksResponse) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_BlocksResponse_pageCount(ctx, field)
+func (ec *executionContext) _GraphQLApp_account(ctx context.Context, field graphql.CollectedField, obj *model.GraphQLApp) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_GraphQLApp_account(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -2350,38 +4683,47 @@ func (ec *executionContext) _BlocksResponse_pageCount(ctx context.Context, field
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.PageCount, nil
+		return ec.resolvers.GraphQLApp().Account(rctx, obj)
 	})
 	if err != nil {
 		ec.Error(ctx, err)
 		return graphql.Null
 	}
 	if resTmp == nil {
-		if !graphql.HasFieldError(ctx, fc) {
-			ec.Errorf(ctx, "must not be null")
-		}
 		return graphql.Null
 	}
-	res := resTmp.(int)
+	res := resTmp.(*model.GraphQLAccount)
 	fc.Result = res
-	return ec.marshalNInt2int(ctx, field.Selections, res)
+	return ec.marshalOGraphQLAccount2ᚖgithubᚗcomᚋpoktᚑfoundationᚋpocketᚑindexerᚑservicesᚋapiᚋgraphᚋmodelᚐGraphQLAccount(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_BlocksResponse_pageCount(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_GraphQLApp_account(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "BlocksResponse",
+		Object:     "GraphQLApp",
 		Field:      field,
-		IsMethod:   false,
-		IsResolver: false,
+		IsMethod:   true,
+		IsResolver: true,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type Int does not have child fields")
+			switch field.Name {
+			case "address":
+				return ec.fieldContext_GraphQLAccount_address(ctx, field)
+			case "height":
+				return ec.fieldContext_GraphQLAccount_height(ctx, field)
+			case "accountType":
+				return ec.fieldContext_GraphQLAccount_accountType(ctx, field)
+			case "balance":
+				return ec.fieldContext_GraphQLAccount_balance(ctx, field)
+			case "balanceDenomination":
+				return ec.fieldContext_GraphQLAccount_balanceDenomination(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type GraphQLAccount", field.Name)
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _BlocksResponse_page(ctx context.Context, field graphql.CollectedField, obj *model.BlocksResponse) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_BlocksResponse_page(ctx, field)
+func (ec *executionContext) _GraphQLApp_transactions(ctx context.Context, field graphql.CollectedField, obj *model.GraphQLApp) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_GraphQLApp_transactions(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -2394,38 +4736,62 @@ func (ec *executionContext) _BlocksResponse_page(ctx context.Context, field grap
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.Page, nil
+		return ec.resolvers.GraphQLApp().Transactions(rctx, obj, fc.Args["page"].(*int), fc.Args["perPage"].(*int), fc.Args["order"].(*postgresdriver.Order), fc.Args["filter"].(*model.TransactionFilter), fc.Args["first"].(*int), fc.Args["after"].(*string), fc.Args["last"].(*int), fc.Args["before"].(*string))
 	})
 	if err != nil {
 		ec.Error(ctx, err)
 		return graphql.Null
 	}
 	if resTmp == nil {
-		if !graphql.HasFieldError(ctx, fc) {
-			ec.Errorf(ctx, "must not be null")
-		}
 		return graphql.Null
 	}
-	res := resTmp.(int)
+	res := resTmp.(*model.TransactionsResponse)
 	fc.Result = res
-	return ec.marshalNInt2int(ctx, field.Selections, res)
+	return ec.marshalOTransactionsResponse2ᚖgithubᚗcomᚋpoktᚑfoundationᚋpocketᚑindexerᚑservicesᚋapiᚋgraphᚋmodelᚐTransactionsResponse(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_BlocksResponse_page(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_GraphQLApp_transactions(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "BlocksResponse",
+		Object:     "GraphQLApp",
 		Field:      field,
-		IsMethod:   false,
-		IsResolver: false,
+		IsMethod:   true,
+		IsResolver: true,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type Int does not have child fields")
+			switch field.Name {
+			case "transactions":
+				return ec.fieldContext_TransactionsResponse_transactions(ctx, field)
+			case "totalCount":
+				return ec.fieldContext_TransactionsResponse_totalCount(ctx, field)
+			case "pageCount":
+				return ec.fieldContext_TransactionsResponse_pageCount(ctx, field)
+			case "page":
+				return ec.fieldContext_TransactionsResponse_page(ctx, field)
+			case "totalPages":
+				return ec.fieldContext_TransactionsResponse_totalPages(ctx, field)
+			case "edges":
+				return ec.fieldContext_TransactionsResponse_edges(ctx, field)
+			case "pageInfo":
+				return ec.fieldContext_TransactionsResponse_pageInfo(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type TransactionsResponse", field.Name)
 		},
 	}
+	defer func() {
+		if r := recover(); r != nil {
+			err = ec.Recover(ctx, r)
+			ec.Error(ctx, err)
+		}
+	}()
+	ctx = graphql.WithFieldContext(ctx, fc)
+	if fc.Args, err = ec.field_GraphQLApp_transactions_args(ctx, field.ArgumentMap(ec.Variables)); err != nil {
+		ec.Error(ctx, err)
+		return
+	}
 	return fc, nil
 }
 
-func (ec *executionContext) _BlocksResponse_totalPages(ctx context.Context, field graphql.CollectedField, obj *model.BlocksResponse) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_BlocksResponse_totalPages(ctx, field)
+func (ec *executionContext) _GraphQLNode_address(ctx context.Context, field graphql.CollectedField, obj *model.GraphQLNode) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_GraphQLNode_address(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -2438,7 +4804,7 @@ func (ec *executionContext) _BlocksResponse_totalPages(ctx context.Context, fiel
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.TotalPages, nil
+		return obj.Address, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -2450,26 +4816,26 @@ func (ec *executionContext) _BlocksResponse_totalPages(ctx context.Context, fiel
 		}
 		return graphql.Null
 	}
-	res := resTmp.(int)
+	res := resTmp.(string)
 	fc.Result = res
-	return ec.marshalNInt2int(ctx, field.Selections, res)
+	return ec.marshalNString2string(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_BlocksResponse_totalPages(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_GraphQLNode_address(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "BlocksResponse",
+		Object:     "GraphQLNode",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type Int does not have child fields")
+			return nil, errors.New("field of type String does not have child fields")
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _Fee_amount(ctx context.Context, field graphql.CollectedField, obj *provider.Fee) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_Fee_amount(ctx, field)
+func (ec *executionContext) _GraphQLNode_height(ctx context.Context, field graphql.CollectedField, obj *model.GraphQLNode) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_GraphQLNode_height(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -2482,7 +4848,7 @@ func (ec *executionContext) _Fee_amount(ctx context.Context, field graphql.Colle
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.Amount, nil
+		return obj.Height, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -2494,26 +4860,26 @@ func (ec *executionContext) _Fee_amount(ctx context.Context, field graphql.Colle
 		}
 		return graphql.Null
 	}
-	res := resTmp.(string)
+	res := resTmp.(int)
 	fc.Result = res
-	return ec.marshalNString2string(ctx, field.Selections, res)
+	return ec.marshalNInt2int(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_Fee_amount(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_GraphQLNode_height(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "Fee",
+		Object:     "GraphQLNode",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type String does not have child fields")
+			return nil, errors.New("field of type Int does not have child fields")
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _Fee_denom(ctx context.Context, field graphql.CollectedField, obj *provider.Fee) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_Fee_denom(ctx, field)
+func (ec *executionContext) _GraphQLNode_jailed(ctx context.Context, field graphql.CollectedField, obj *model.GraphQLNode) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_GraphQLNode_jailed(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -2526,7 +4892,7 @@ func (ec *executionContext) _Fee_denom(ctx context.Context, field graphql.Collec
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.Denom, nil
+		return obj.Jailed, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -2538,26 +4904,26 @@ func (ec *executionContext) _Fee_denom(ctx context.Context, field graphql.Collec
 		}
 		return graphql.Null
 	}
-	res := resTmp.(string)
+	res := resTmp.(bool)
 	fc.Result = res
-	return ec.marshalNString2string(ctx, field.Selections, res)
+	return ec.marshalNBoolean2bool(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_Fee_denom(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_GraphQLNode_jailed(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "Fee",
+		Object:     "GraphQLNode",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type String does not have child fields")
+			return nil, errors.New("field of type Boolean does not have child fields")
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _GraphQLAccount_address(ctx context.Context, field graphql.CollectedField, obj *model.GraphQLAccount) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_GraphQLAccount_address(ctx, field)
+func (ec *executionContext) _GraphQLNode_publicKey(ctx context.Context, field graphql.CollectedField, obj *model.GraphQLNode) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_GraphQLNode_publicKey(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -2570,7 +4936,7 @@ func (ec *executionContext) _GraphQLAccount_address(ctx context.Context, field g
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.Address, nil
+		return obj.PublicKey, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -2587,9 +4953,9 @@ func (ec *executionContext) _GraphQLAccount_address(ctx context.Context, field g
 	return ec.marshalNString2string(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_GraphQLAccount_address(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_GraphQLNode_publicKey(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "GraphQLAccount",
+		Object:     "GraphQLNode",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
@@ -2600,8 +4966,8 @@ func (ec *executionContext) fieldContext_GraphQLAccount_address(ctx context.Cont
 	return fc, nil
 }
 
-func (ec *executionContext) _GraphQLAccount_height(ctx context.Context, field graphql.CollectedField, obj *model.GraphQLAccount) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_GraphQLAccount_height(ctx, field)
+func (ec *executionContext) _GraphQLNode_serviceURL(ctx context.Context, field graphql.CollectedField, obj *model.GraphQLNode) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_GraphQLNode_serviceURL(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -2614,7 +4980,7 @@ func (ec *executionContext) _GraphQLAccount_height(ctx context.Context, field gr
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.Height, nil
+		return obj.ServiceURL, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -2626,26 +4992,26 @@ func (ec *executionContext) _GraphQLAccount_height(ctx context.Context, field gr
 		}
 		return graphql.Null
 	}
-	res := resTmp.(int)
+	res := resTmp.(string)
 	fc.Result = res
-	return ec.marshalNInt2int(ctx, field.Selections, res)
+	return ec.marshalNString2string(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_GraphQLAccount_height(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_GraphQLNode_serviceURL(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "GraphQLAccount",
+		Object:     "GraphQLNode",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type Int does not have child fields")
+			return nil, errors.New("field of type String does not have child fields")
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _GraphQLAccount_accountType(ctx context.Context, field graphql.CollectedField, obj *model.GraphQLAccount) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_GraphQLAccount_accountType(ctx, field)
+func (ec *executionContext) _GraphQLNode_tokens(ctx context.Context, field graphql.CollectedField, obj *model.GraphQLNode) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_GraphQLNode_tokens(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -2658,7 +5024,7 @@ func (ec *executionContext) _GraphQLAccount_accountType(ctx context.Context, fie
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.AccountType, nil
+		return obj.Tokens, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -2675,9 +5041,9 @@ func (ec *executionContext) _GraphQLAccount_accountType(ctx context.Context, fie
 	return ec.marshalNString2string(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_GraphQLAccount_accountType(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_GraphQLNode_tokens(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "GraphQLAccount",
+		Object:     "GraphQLNode",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
@@ -2688,8 +5054,8 @@ func (ec *executionContext) fieldContext_GraphQLAccount_accountType(ctx context.
 	return fc, nil
 }
 
-func (ec *executionContext) _GraphQLAccount_balance(ctx context.Context, field graphql.CollectedField, obj *model.GraphQLAccount) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_GraphQLAccount_balance(ctx, field)
+func (ec *executionContext) _GraphQLNode_account(ctx context.Context, field graphql.CollectedField, obj *model.GraphQLNode) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_GraphQLNode_account(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -2702,38 +5068,47 @@ func (ec *executionContext) _GraphQLAccount_balance(ctx context.Context, field g
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.Balance, nil
+		return ec.resolvers.GraphQLNode().Account(rctx, obj)
 	})
 	if err != nil {
 		ec.Error(ctx, err)
 		return graphql.Null
 	}
 	if resTmp == nil {
-		if !graphql.HasFieldError(ctx, fc) {
-			ec.Errorf(ctx, "must not be null")
-		}
 		return graphql.Null
 	}
-	res := resTmp.(string)
+	res := resTmp.(*model.GraphQLAccount)
 	fc.Result = res
-	return ec.marshalNString2string(ctx, field.Selections, res)
+	return ec.marshalOGraphQLAccount2ᚖgithubᚗcomᚋpoktᚑfoundationᚋpocketᚑindexerᚑservicesᚋapiᚋgraphᚋmodelᚐGraphQLAccount(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_GraphQLAccount_balance(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_GraphQLNode_account(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "GraphQLAccount",
+		Object:     "GraphQLNode",
 		Field:      field,
-		IsMethod:   false,
-		IsResolver: false,
+		IsMethod:   true,
+		IsResolver: true,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type String does not have child fields")
+			switch field.Name {
+			case "address":
+				return ec.fieldContext_GraphQLAccount_address(ctx, field)
+			case "height":
+				return ec.fieldContext_GraphQLAccount_height(ctx, field)
+			case "accountType":
+				return ec.fieldContext_GraphQLAccount_accountType(ctx, field)
+			case "balance":
+				return ec.fieldContext_GraphQLAccount_balance(ctx, field)
+			case "balanceDenomination":
+				return ec.fieldContext_GraphQLAccount_balanceDenomination(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type GraphQLAccount", field.Name)
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _GraphQLAccount_balanceDenomination(ctx context.Context, field graphql.CollectedField, obj *model.GraphQLAccount) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_GraphQLAccount_balanceDenomination(ctx, field)
+func (ec *executionContext) _GraphQLNode_transactions(ctx context.Context, field graphql.CollectedField, obj *model.GraphQLNode) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_GraphQLNode_transactions(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -2746,38 +5121,62 @@ func (ec *executionContext) _GraphQLAccount_balanceDenomination(ctx context.Cont
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.BalanceDenomination, nil
+		return ec.resolvers.GraphQLNode().Transactions(rctx, obj, fc.Args["page"].(*int), fc.Args["perPage"].(*int), fc.Args["order"].(*postgresdriver.Order), fc.Args["filter"].(*model.TransactionFilter), fc.Args["first"].(*int), fc.Args["after"].(*string), fc.Args["last"].(*int), fc.Args["before"].(*string))
 	})
 	if err != nil {
 		ec.Error(ctx, err)
 		return graphql.Null
 	}
 	if resTmp == nil {
-		if !graphql.HasFieldError(ctx, fc) {
-			ec.Errorf(ctx, "must not be null")
-		}
 		return graphql.Null
 	}
-	res := resTmp.(string)
+	res := resTmp.(*model.TransactionsResponse)
 	fc.Result = res
-	return ec.marshalNString2string(ctx, field.Selections, res)
+	return ec.marshalOTransactionsResponse2ᚖgithubᚗcomᚋpoktᚑfoundationᚋpocketᚑindexerᚑservicesᚋapiᚋgraphᚋmodelᚐTransactionsResponse(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_GraphQLAccount_balanceDenomination(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_GraphQLNode_transactions(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "GraphQLAccount",
+		Object:     "GraphQLNode",
 		Field:      field,
-		IsMethod:   false,
-		IsResolver: false,
+		IsMethod:   true,
+		IsResolver: true,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type String does not have child fields")
+			switch field.Name {
+			case "transactions":
+				return ec.fieldContext_TransactionsResponse_transactions(ctx, field)
+			case "totalCount":
+				return ec.fieldContext_TransactionsResponse_totalCount(ctx, field)
+			case "pageCount":
+				return ec.fieldContext_TransactionsResponse_pageCount(ctx, field)
+			case "page":
+				return ec.fieldContext_TransactionsResponse_page(ctx, field)
+			case "totalPages":
+				return ec.fieldContext_TransactionsResponse_totalPages(ctx, field)
+			case "edges":
+				return ec.fieldContext_TransactionsResponse_edges(ctx, field)
+			case "pageInfo":
+				return ec.fieldContext_TransactionsResponse_pageInfo(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type TransactionsResponse", field.Name)
 		},
 	}
+	defer func() {
+		if r := recover(); r != nil {
+			err = ec.Recover(ctx, r)
+			ec.Error(ctx, err)
+		}
+	}()
+	ctx = graphql.WithFieldContext(ctx, fc)
+	if fc.Args, err = ec.field_GraphQLNode_transactions_args(ctx, field.ArgumentMap(ec.Variables)); err != nil {
+		ec.Error(ctx, err)
+		return
+	}
 	return fc, nil
 }
 
-func (ec *executionContext) _GraphQLApp_address(ctx context.Context, field graphql.CollectedField, obj *model.GraphQLApp) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_GraphQLApp_address(ctx, field)
+func (ec *executionContext) _GraphQLTransaction_hash(ctx context.Context, field graphql.CollectedField, obj *model.GraphQLTransaction) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_GraphQLTransaction_hash(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -2790,7 +5189,7 @@ func (ec *executionContext) _GraphQLApp_address(ctx context.Context, field graph
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.Address, nil
+		return obj.Hash, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -2807,9 +5206,9 @@ func (ec *executionContext) _GraphQLApp_address(ctx context.Context, field graph
 	return ec.marshalNString2string(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_GraphQLApp_address(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_GraphQLTransaction_hash(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "GraphQLApp",
+		Object:     "GraphQLTransaction",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
@@ -2820,8 +5219,8 @@ func (ec *executionContext) fieldContext_GraphQLApp_address(ctx context.Context,
 	return fc, nil
 }
 
-func (ec *executionContext) _GraphQLApp_height(ctx context.Context, field graphql.CollectedField, obj *model.GraphQLApp) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_GraphQLApp_height(ctx, field)
+func (ec *executionContext) _GraphQLTransaction_fromAddress(ctx context.Context, field graphql.CollectedField, obj *model.GraphQLTransaction) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_GraphQLTransaction_fromAddress(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -2834,7 +5233,7 @@ func (ec *executionContext) _GraphQLApp_height(ctx context.Context, field graphq
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.Height, nil
+		return obj.FromAddress, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -2846,26 +5245,26 @@ func (ec *executionContext) _GraphQLApp_height(ctx context.Context, field graphq
 		}
 		return graphql.Null
 	}
-	res := resTmp.(int)
+	res := resTmp.(string)
 	fc.Result = res
-	return ec.marshalNInt2int(ctx, field.Selections, res)
+	return ec.marshalNString2string(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_GraphQLApp_height(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_GraphQLTransaction_fromAddress(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "GraphQLApp",
+		Object:     "GraphQLTransaction",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type Int does not have child fields")
+			return nil, errors.New("field of type String does not have child fields")
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _GraphQLApp_jailed(ctx context.Context, field graphql.CollectedField, obj *model.GraphQLApp) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_GraphQLApp_jailed(ctx, field)
+func (ec *executionContext) _GraphQLTransaction_toAddress(ctx context.Context, field graphql.CollectedField, obj *model.GraphQLTransaction) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_GraphQLTransaction_toAddress(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -2878,7 +5277,7 @@ func (ec *executionContext) _GraphQLApp_jailed(ctx context.Context, field graphq
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.Jailed, nil
+		return obj.ToAddress, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -2890,26 +5289,26 @@ func (ec *executionContext) _GraphQLApp_jailed(ctx context.Context, field graphq
 		}
 		return graphql.Null
 	}
-	res := resTmp.(bool)
+	res := resTmp.(string)
 	fc.Result = res
-	return ec.marshalNBoolean2bool(ctx, field.Selections, res)
+	return ec.marshalNString2string(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_GraphQLApp_jailed(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_GraphQLTransaction_toAddress(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "GraphQLApp",
+		Object:     "GraphQLTransaction",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type Boolean does not have child fields")
+			return nil, errors.New("field of type String does not have child fields")
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _GraphQLApp_publicKey(ctx context.Context, field graphql.CollectedField, obj *model.GraphQLApp) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_GraphQLApp_publicKey(ctx, field)
+func (ec *executionContext) _GraphQLTransaction_appPubKey(ctx context.Context, field graphql.CollectedField, obj *model.GraphQLTransaction) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_GraphQLTransaction_appPubKey(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -2922,7 +5321,7 @@ func (ec *executionContext) _GraphQLApp_publicKey(ctx context.Context, field gra
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.PublicKey, nil
+		return obj.AppPubKey, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -2939,9 +5338,9 @@ func (ec *executionContext) _GraphQLApp_publicKey(ctx context.Context, field gra
 	return ec.marshalNString2string(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_GraphQLApp_publicKey(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_GraphQLTransaction_appPubKey(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "GraphQLApp",
+		Object:     "GraphQLTransaction",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
@@ -2952,8 +5351,8 @@ func (ec *executionContext) fieldContext_GraphQLApp_publicKey(ctx context.Contex
 	return fc, nil
 }
 
-func (ec *executionContext) _GraphQLApp_stakedTokens(ctx context.Context, field graphql.CollectedField, obj *model.GraphQLApp) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_GraphQLApp_stakedTokens(ctx, field)
+func (ec *executionContext) _GraphQLTransaction_blockchains(ctx context.Context, field graphql.CollectedField, obj *model.GraphQLTransaction) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_GraphQLTransaction_blockchains(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -2966,26 +5365,23 @@ func (ec *executionContext) _GraphQLApp_stakedTokens(ctx context.Context, field
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.StakedTokens, nil
+		return obj.Blockchains, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
 		return graphql.Null
 	}
 	if resTmp == nil {
-		if !graphql.HasFieldError(ctx, fc) {
-			ec.Errorf(ctx, "must not be null")
-		}
 		return graphql.Null
 	}
-	res := resTmp.(string)
+	res := resTmp.([]string)
 	fc.Result = res
-	return ec.marshalNString2string(ctx, field.Selections, res)
+	return ec.marshalOString2ᚕstringᚄ(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_GraphQLApp_stakedTokens(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_GraphQLTransaction_blockchains(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "GraphQLApp",
+		Object:     "GraphQLTransaction",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
@@ -2996,8 +5392,8 @@ func (ec *executionContext) fieldContext_GraphQLApp_stakedTokens(ctx context.Con
 	return fc, nil
 }
 
-func (ec *executionContext) _GraphQLNode_address(ctx context.Context, field graphql.CollectedField, obj *model.GraphQLNode) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_GraphQLNode_address(ctx, field)
+func (ec *executionContext) _GraphQLTransaction_messageType(ctx context.Context, field graphql.CollectedField, obj *model.GraphQLTransaction) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_GraphQLTransaction_messageType(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -3010,7 +5406,7 @@ func (ec *executionContext) _GraphQLNode_address(ctx context.Context, field grap
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.Address, nil
+		return obj.MessageType, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -3027,9 +5423,9 @@ func (ec *executionContext) _GraphQLNode_address(ctx context.Context, field grap
 	return ec.marshalNString2string(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_GraphQLNode_address(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_GraphQLTransaction_messageType(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "GraphQLNode",
+		Object:     "GraphQLTransaction",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
@@ -3040,8 +5436,8 @@ func (ec *executionContext) fieldContext_GraphQLNode_address(ctx context.Context
 	return fc, nil
 }
 
-func (ec *executionContext) _GraphQLNode_height(ctx context.Context, field graphql.CollectedField, obj *model.GraphQLNode) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_GraphQLNode_height(ctx, field)
+func (ec *executionContext) _GraphQLTransaction_height(ctx context.Context, field graphql.CollectedField, obj *model.GraphQLTransaction) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_GraphQLTransaction_height(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -3071,9 +5467,9 @@ func (ec *executionContext) _GraphQLNode_height(ctx context.Context, field graph
 	return ec.marshalNInt2int(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_GraphQLNode_height(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_GraphQLTransaction_height(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "GraphQLNode",
+		Object:     "GraphQLTransaction",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
@@ -3084,8 +5480,8 @@ func (ec *executionContext) fieldContext_GraphQLNode_height(ctx context.Context,
 	return fc, nil
 }
 
-func (ec *executionContext) _GraphQLNode_jailed(ctx context.Context, field graphql.CollectedField, obj *model.GraphQLNode) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_GraphQLNode_jailed(ctx, field)
+func (ec *executionContext) _GraphQLTransaction_index(ctx context.Context, field graphql.CollectedField, obj *model.GraphQLTransaction) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_GraphQLTransaction_index(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -3098,7 +5494,7 @@ func (ec *executionContext) _GraphQLNode_jailed(ctx context.Context, field graph
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.Jailed, nil
+		return obj.Index, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -3110,26 +5506,26 @@ func (ec *executionContext) _GraphQLNode_jailed(ctx context.Context, field graph
 		}
 		return graphql.Null
 	}
-	res := resTmp.(bool)
+	res := resTmp.(int)
 	fc.Result = res
-	return ec.marshalNBoolean2bool(ctx, field.Selections, res)
+	return ec.marshalNInt2int(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_GraphQLNode_jailed(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_GraphQLTransaction_index(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "GraphQLNode",
+		Object:     "GraphQLTransaction",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type Boolean does not have child fields")
+			return nil, errors.New("field of type Int does not have child fields")
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _GraphQLNode_publicKey(ctx context.Context, field graphql.CollectedField, obj *model.GraphQLNode) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_GraphQLNode_publicKey(ctx, field)
+func (ec *executionContext) _GraphQLTransaction_stdTx(ctx context.Context, field graphql.CollectedField, obj *model.GraphQLTransaction) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_GraphQLTransaction_stdTx(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -3142,38 +5538,47 @@ func (ec *executionContext) _GraphQLNode_publicKey(ctx context.Context, field gr
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.PublicKey, nil
+		return obj.StdTx, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
 		return graphql.Null
 	}
 	if resTmp == nil {
-		if !graphql.HasFieldError(ctx, fc) {
-			ec.Errorf(ctx, "must not be null")
-		}
 		return graphql.Null
 	}
-	res := resTmp.(string)
+	res := resTmp.(*provider.StdTx)
 	fc.Result = res
-	return ec.marshalNString2string(ctx, field.Selections, res)
+	return ec.marshalOStdTx2ᚖgithubᚗcomᚋpoktᚑfoundationᚋpocketᚑgoᚋproviderᚐStdTx(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_GraphQLNode_publicKey(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_GraphQLTransaction_stdTx(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "GraphQLNode",
+		Object:     "GraphQLTransaction",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type String does not have child fields")
+			switch field.Name {
+			case "entropy":
+				return ec.fieldContext_StdTx_entropy(ctx, field)
+			case "fee":
+				return ec.fieldContext_StdTx_fee(ctx, field)
+			case "memo":
+				return ec.fieldContext_StdTx_memo(ctx, field)
+			case "msg":
+				return ec.fieldContext_StdTx_msg(ctx, field)
+			case "signature":
+				return ec.fieldContext_StdTx_signature(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type StdTx", field.Name)
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _GraphQLNode_serviceURL(ctx context.Context, field graphql.CollectedField, obj *model.GraphQLNode) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_GraphQLNode_serviceURL(ctx, field)
+func (ec *executionContext) _GraphQLTransaction_txResult(ctx context.Context, field graphql.CollectedField, obj *model.GraphQLTransaction) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_GraphQLTransaction_txResult(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -3186,38 +5591,55 @@ func (ec *executionContext) _GraphQLNode_serviceURL(ctx context.Context, field g
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.ServiceURL, nil
+		return obj.TxResult, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
 		return graphql.Null
 	}
 	if resTmp == nil {
-		if !graphql.HasFieldError(ctx, fc) {
-			ec.Errorf(ctx, "must not be null")
-		}
 		return graphql.Null
 	}
-	res := resTmp.(string)
+	res := resTmp.(*provider.TxResult)
 	fc.Result = res
-	return ec.marshalNString2string(ctx, field.Selections, res)
+	return ec.marshalOTxResult2ᚖgithubᚗcomᚋpoktᚑfoundationᚋpocketᚑgoᚋproviderᚐTxResult(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_GraphQLNode_serviceURL(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_GraphQLTransaction_txResult(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "GraphQLNode",
+		Object:     "GraphQLTransaction",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type String does not have child fields")
+			switch field.Name {
+			case "code":
+				return ec.fieldContext_TxResult_code(ctx, field)
+			case "codespace":
+				return ec.fieldContext_TxResult_codespace(ctx, field)
+			case "data":
+				return ec.fieldContext_TxResult_data(ctx, field)
+			case "events":
+				return ec.fieldContext_TxResult_events(ctx, field)
+			case "info":
+				return ec.fieldContext_TxResult_info(ctx, field)
+			case "log":
+				return ec.fieldContext_TxResult_log(ctx, field)
+			case "messageType":
+				return ec.fieldContext_TxResult_messageType(ctx, field)
+			case "recipient":
+				return ec.fieldContext_TxResult_recipient(ctx, field)
+			case "signer":
+				return ec.fieldContext_TxResult_signer(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type TxResult", field.Name)
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _GraphQLNode_tokens(ctx context.Context, field graphql.CollectedField, obj *model.GraphQLNode) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_GraphQLNode_tokens(ctx, field)
+func (ec *executionContext) _GraphQLTransaction_tx(ctx context.Context, field graphql.CollectedField, obj *model.GraphQLTransaction) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_GraphQLTransaction_tx(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -3230,7 +5652,7 @@ func (ec *executionContext) _GraphQLNode_tokens(ctx context.Context, field graph
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.Tokens, nil
+		return obj.Tx, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -3247,9 +5669,9 @@ func (ec *executionContext) _GraphQLNode_tokens(ctx context.Context, field graph
 	return ec.marshalNString2string(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_GraphQLNode_tokens(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_GraphQLTransaction_tx(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "GraphQLNode",
+		Object:     "GraphQLTransaction",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
@@ -3260,8 +5682,8 @@ func (ec *executionContext) fieldContext_GraphQLNode_tokens(ctx context.Context,
 	return fc, nil
 }
 
-func (ec *executionContext) _GraphQLTransaction_hash(ctx context.Context, field graphql.CollectedField, obj *model.GraphQLTransaction) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_GraphQLTransaction_hash(ctx, field)
+func (ec *executionContext) _GraphQLTransaction_entropy(ctx context.Context, field graphql.CollectedField, obj *model.GraphQLTransaction) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_GraphQLTransaction_entropy(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -3274,7 +5696,7 @@ func (ec *executionContext) _GraphQLTransaction_hash(ctx context.Context, field
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.Hash, nil
+		return obj.Entropy, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -3291,7 +5713,7 @@ func (ec *executionContext) _GraphQLTransaction_hash(ctx context.Context, field
 	return ec.marshalNString2string(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_GraphQLTransaction_hash(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_GraphQLTransaction_entropy(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
 		Object:     "GraphQLTransaction",
 		Field:      field,
@@ -3304,8 +5726,8 @@ func (ec *executionContext) fieldContext_GraphQLTransaction_hash(ctx context.Con
 	return fc, nil
 }
 
-func (ec *executionContext) _GraphQLTransaction_fromAddress(ctx context.Context, field graphql.CollectedField, obj *model.GraphQLTransaction) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_GraphQLTransaction_fromAddress(ctx, field)
+func (ec *executionContext) _GraphQLTransaction_fee(ctx context.Context, field graphql.CollectedField, obj *model.GraphQLTransaction) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_GraphQLTransaction_fee(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -3318,7 +5740,7 @@ func (ec *executionContext) _GraphQLTransaction_fromAddress(ctx context.Context,
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.FromAddress, nil
+		return obj.Fee, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -3330,26 +5752,26 @@ func (ec *executionContext) _GraphQLTransaction_fromAddress(ctx context.Context,
 		}
 		return graphql.Null
 	}
-	res := resTmp.(string)
+	res := resTmp.(int)
 	fc.Result = res
-	return ec.marshalNString2string(ctx, field.Selections, res)
+	return ec.marshalNInt2int(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_GraphQLTransaction_fromAddress(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_GraphQLTransaction_fee(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
 		Object:     "GraphQLTransaction",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type String does not have child fields")
+			return nil, errors.New("field of type Int does not have child fields")
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _GraphQLTransaction_toAddress(ctx context.Context, field graphql.CollectedField, obj *model.GraphQLTransaction) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_GraphQLTransaction_toAddress(ctx, field)
+func (ec *executionContext) _GraphQLTransaction_feeDenomination(ctx context.Context, field graphql.CollectedField, obj *model.GraphQLTransaction) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_GraphQLTransaction_feeDenomination(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -3362,7 +5784,7 @@ func (ec *executionContext) _GraphQLTransaction_toAddress(ctx context.Context, f
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.ToAddress, nil
+		return obj.FeeDenomination, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -3379,7 +5801,7 @@ func (ec *executionContext) _GraphQLTransaction_toAddress(ctx context.Context, f
 	return ec.marshalNString2string(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_GraphQLTransaction_toAddress(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_GraphQLTransaction_feeDenomination(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
 		Object:     "GraphQLTransaction",
 		Field:      field,
@@ -3392,8 +5814,8 @@ func (ec *executionContext) fieldContext_GraphQLTransaction_toAddress(ctx contex
 	return fc, nil
 }
 
-func (ec *executionContext) _GraphQLTransaction_appPubKey(ctx context.Context, field graphql.CollectedField, obj *model.GraphQLTransaction) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_GraphQLTransaction_appPubKey(ctx, field)
+func (ec *executionContext) _GraphQLTransaction_amount(ctx context.Context, field graphql.CollectedField, obj *model.GraphQLTransaction) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_GraphQLTransaction_amount(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -3406,7 +5828,7 @@ func (ec *executionContext) _GraphQLTransaction_appPubKey(ctx context.Context, f
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.AppPubKey, nil
+		return obj.Amount, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -3423,7 +5845,7 @@ func (ec *executionContext) _GraphQLTransaction_appPubKey(ctx context.Context, f
 	return ec.marshalNString2string(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_GraphQLTransaction_appPubKey(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_GraphQLTransaction_amount(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
 		Object:     "GraphQLTransaction",
 		Field:      field,
@@ -3436,8 +5858,8 @@ func (ec *executionContext) fieldContext_GraphQLTransaction_appPubKey(ctx contex
 	return fc, nil
 }
 
-func (ec *executionContext) _GraphQLTransaction_blockchains(ctx context.Context, field graphql.CollectedField, obj *model.GraphQLTransaction) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_GraphQLTransaction_blockchains(ctx, field)
+func (ec *executionContext) _GraphQLTransaction_block(ctx context.Context, field graphql.CollectedField, obj *model.GraphQLTransaction) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_GraphQLTransaction_block(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -3450,7 +5872,7 @@ func (ec *executionContext) _GraphQLTransaction_blockchains(ctx context.Context,
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.Blockchains, nil
+		return ec.resolvers.GraphQLTransaction().Block(rctx, obj)
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -3459,26 +5881,40 @@ func (ec *executionContext) _GraphQLTransaction_blockchains(ctx context.Context,
 	if resTmp == nil {
 		return graphql.Null
 	}
-	res := resTmp.([]string)
+	res := resTmp.(*indexer.Block)
 	fc.Result = res
-	return ec.marshalOString2ᚕstringᚄ(ctx, field.Selections, res)
+	return ec.marshalOBlock2ᚖgithubᚗcomᚋpoktᚑfoundationᚋpocketᚑindexerᚑlibᚐBlock(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_GraphQLTransaction_blockchains(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_GraphQLTransaction_block(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
 		Object:     "GraphQLTransaction",
 		Field:      field,
-		IsMethod:   false,
-		IsResolver: false,
+		IsMethod:   true,
+		IsResolver: true,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type String does not have child fields")
+			switch field.Name {
+			case "hash":
+				return ec.fieldContext_Block_hash(ctx, field)
+			case "height":
+				return ec.fieldContext_Block_height(ctx, field)
+			case "time":
+				return ec.fieldContext_Block_time(ctx, field)
+			case "proposerAddress":
+				return ec.fieldContext_Block_proposerAddress(ctx, field)
+			case "txCount":
+				return ec.fieldContext_Block_txCount(ctx, field)
+			case "transactions":
+				return ec.fieldContext_Block_transactions(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type Block", field.Name)
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _GraphQLTransaction_messageType(ctx context.Context, field graphql.CollectedField, obj *model.GraphQLTransaction) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_GraphQLTransaction_messageType(ctx, field)
+func (ec *executionContext) _GraphQLTransaction_fromAccount(ctx context.Context, field graphql.CollectedField, obj *model.GraphQLTransaction) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_GraphQLTransaction_fromAccount(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -3491,38 +5927,47 @@ func (ec *executionContext) _GraphQLTransaction_messageType(ctx context.Context,
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.MessageType, nil
+		return ec.resolvers.GraphQLTransaction().FromAccount(rctx, obj)
 	})
 	if err != nil {
 		ec.Error(ctx, err)
 		return graphql.Null
 	}
 	if resTmp == nil {
-		if !graphql.HasFieldError(ctx, fc) {
-			ec.Errorf(ctx, "must not be null")
-		}
 		return graphql.Null
 	}
-	res := resTmp.(string)
+	res := resTmp.(*model.GraphQLAccount)
 	fc.Result = res
-	return ec.marshalNString2string(ctx, field.Selections, res)
+	return ec.marshalOGraphQLAccount2ᚖgithubᚗcomᚋpoktᚑfoundationᚋpocketᚑindexerᚑservicesᚋapiᚋgraphᚋmodelᚐGraphQLAccount(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_GraphQLTransaction_messageType(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_GraphQLTransaction_fromAccount(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
 		Object:     "GraphQLTransaction",
 		Field:      field,
-		IsMethod:   false,
-		IsResolver: false,
+		IsMethod:   true,
+		IsResolver: true,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type String does not have child fields")
+			switch field.Name {
+			case "address":
+				return ec.fieldContext_GraphQLAccount_address(ctx, field)
+			case "height":
+				return ec.fieldContext_GraphQLAccount_height(ctx, field)
+			case "accountType":
+				return ec.fieldContext_GraphQLAccount_accountType(ctx, field)
+			case "balance":
+				return ec.fieldContext_GraphQLAccount_balance(ctx, field)
+			case "balanceDenomination":
+				return ec.fieldContext_GraphQLAccount_balanceDenomination(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type GraphQLAccount", field.Name)
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _GraphQLTransaction_height(ctx context.Context, field graphql.CollectedField, obj *model.GraphQLTransaction) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_GraphQLTransaction_height(ctx, field)
+func (ec *executionContext) _GraphQLTransaction_toAccount(ctx context.Context, field graphql.CollectedField, obj *model.GraphQLTransaction) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_GraphQLTransaction_toAccount(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -3535,38 +5980,47 @@ func (ec *executionContext) _GraphQLTransaction_height(ctx context.Context, fiel
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.Height, nil
+		return ec.resolvers.GraphQLTransaction().ToAccount(rctx, obj)
 	})
 	if err != nil {
 		ec.Error(ctx, err)
 		return graphql.Null
 	}
 	if resTmp == nil {
-		if !graphql.HasFieldError(ctx, fc) {
-			ec.Errorf(ctx, "must not be null")
-		}
 		return graphql.Null
 	}
-	res := resTmp.(int)
+	res := resTmp.(*model.GraphQLAccount)
 	fc.Result = res
-	return ec.marshalNInt2int(ctx, field.Selections, res)
+	return ec.marshalOGraphQLAccount2ᚖgithubᚗcomᚋpoktᚑfoundationᚋpocketᚑindexerᚑservicesᚋapiᚋgraphᚋmodelᚐGraphQLAccount(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_GraphQLTransaction_height(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_GraphQLTransaction_toAccount(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
 		Object:     "GraphQLTransaction",
 		Field:      field,
-		IsMethod:   false,
-		IsResolver: false,
+		IsMethod:   true,
+		IsResolver: true,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type Int does not have child fields")
+			switch field.Name {
+			case "address":
+				return ec.fieldContext_GraphQLAccount_address(ctx, field)
+			case "height":
+				return ec.fieldContext_GraphQLAccount_height(ctx, field)
+			case "accountType":
+				return ec.fieldContext_GraphQLAccount_accountType(ctx, field)
+			case "balance":
+				return ec.fieldContext_GraphQLAccount_balance(ctx, field)
+			case "balanceDenomination":
+				return ec.fieldContext_GraphQLAccount_balanceDenomination(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type GraphQLAccount", field.Name)
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _GraphQLTransaction_index(ctx context.Context, field graphql.CollectedField, obj *model.GraphQLTransaction) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_GraphQLTransaction_index(ctx, field)
+func (ec *executionContext) _NodeEdge_cursor(ctx context.Context, field graphql.CollectedField, obj *model.NodeEdge) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_NodeEdge_cursor(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -3579,7 +6033,7 @@ func (ec *executionContext) _GraphQLTransaction_index(ctx context.Context, field
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.Index, nil
+		return obj.Cursor, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -3591,26 +6045,26 @@ func (ec *executionContext) _GraphQLTransaction_index(ctx context.Context, field
 		}
 		return graphql.Null
 	}
-	res := resTmp.(int)
+	res := resTmp.(string)
 	fc.Result = res
-	return ec.marshalNInt2int(ctx, field.Selections, res)
+	return ec.marshalNString2string(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_GraphQLTransaction_index(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_NodeEdge_cursor(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "GraphQLTransaction",
+		Object:     "NodeEdge",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type Int does not have child fields")
+			return nil, errors.New("field of type String does not have child fields")
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _GraphQLTransaction_stdTx(ctx context.Context, field graphql.CollectedField, obj *model.GraphQLTransaction) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_GraphQLTransaction_stdTx(ctx, field)
+func (ec *executionContext) _NodeEdge_node(ctx context.Context, field graphql.CollectedField, obj *model.NodeEdge) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_NodeEdge_node(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -3623,47 +6077,56 @@ func (ec *executionContext) _GraphQLTransaction_stdTx(ctx context.Context, field
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.StdTx, nil
+		return obj.Node, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
 		return graphql.Null
 	}
 	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
 		return graphql.Null
 	}
-	res := resTmp.(*provider.StdTx)
+	res := resTmp.(*model.GraphQLNode)
 	fc.Result = res
-	return ec.marshalOStdTx2ᚖgithubᚗcomᚋpoktᚑfoundationᚋpocketᚑgoᚋproviderᚐStdTx(ctx, field.Selections, res)
+	return ec.marshalNGraphQLNode2ᚖgithubᚗcomᚋpoktᚑfoundationᚋpocketᚑindexerᚑservicesᚋapiᚋgraphᚋmodelᚐGraphQLNode(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_GraphQLTransaction_stdTx(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_NodeEdge_node(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "GraphQLTransaction",
+		Object:     "NodeEdge",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
 			switch field.Name {
-			case "entropy":
-				return ec.fieldContext_StdTx_entropy(ctx, field)
-			case "fee":
-				return ec.fieldContext_StdTx_fee(ctx, field)
-			case "memo":
-				return ec.fieldContext_StdTx_memo(ctx, field)
-			case "msg":
-				return ec.fieldContext_StdTx_msg(ctx, field)
-			case "signature":
-				return ec.fieldContext_StdTx_signature(ctx, field)
+			case "address":
+				return ec.fieldContext_GraphQLNode_address(ctx, field)
+			case "height":
+				return ec.fieldContext_GraphQLNode_height(ctx, field)
+			case "jailed":
+				return ec.fieldContext_GraphQLNode_jailed(ctx, field)
+			case "publicKey":
+				return ec.fieldContext_GraphQLNode_publicKey(ctx, field)
+			case "serviceURL":
+				return ec.fieldContext_GraphQLNode_serviceURL(ctx, field)
+			case "tokens":
+				return ec.fieldContext_GraphQLNode_tokens(ctx, field)
+			case "account":
+				return ec.fieldContext_GraphQLNode_account(ctx, field)
+			case "transactions":
+				return ec.fieldContext_GraphQLNode_transactions(ctx, field)
 			}
-			return nil, fmt.Errorf("no field named %q was found under type StdTx", field.Name)
+			return nil, fmt.Errorf("no field named %q was found under type GraphQLNode", field.Name)
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _GraphQLTransaction_txResult(ctx context.Context, field graphql.CollectedField, obj *model.GraphQLTransaction) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_GraphQLTransaction_txResult(ctx, field)
+func (ec *executionContext) _NodesResponse_nodes(ctx context.Context, field graphql.CollectedField, obj *model.NodesResponse) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_NodesResponse_nodes(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -3676,7 +6139,7 @@ func (ec *executionContext) _GraphQLTransaction_txResult(ctx context.Context, fi
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.TxResult, nil
+		return obj.Nodes, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -3685,46 +6148,44 @@ func (ec *executionContext) _GraphQLTransaction_txResult(ctx context.Context, fi
 	if resTmp == nil {
 		return graphql.Null
 	}
-	res := resTmp.(*provider.TxResult)
+	res := resTmp.([]*model.GraphQLNode)
 	fc.Result = res
-	return ec.marshalOTxResult2ᚖgithubᚗcomᚋpoktᚑfoundationᚋpocketᚑgoᚋproviderᚐTxResult(ctx, field.Selections, res)
-}
-
-func (ec *executionContext) fieldContext_GraphQLTransaction_txResult(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
-	fc = &graphql.FieldContext{
-		Object:     "GraphQLTransaction",
-		Field:      field,
-		IsMethod:   false,
-		IsResolver: false,
-		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			switch field.Name {
-			case "code":
-				return ec.fieldContext_TxResult_code(ctx, field)
-			case "codespace":
-				return ec.fieldContext_TxResult_codespace(ctx, field)
-			case "data":
-				return ec.fieldContext_TxResult_data(ctx, field)
-			case "events":
-				return ec.fieldContext_TxResult_events(ctx, field)
-			case "info":
-				return ec.fieldContext_TxResult_info(ctx, field)
-			case "log":
-				return ec.fieldContext_TxResult_log(ctx, field)
-			case "messageType":
-				return ec.fieldContext_TxResult_messageType(ctx, field)
-			case "recipient":
-				return ec.fieldContext_TxResult_recipient(ctx, field)
-			case "signer":
-				return ec.fieldContext_TxResult_signer(ctx, field)
+	return ec.marshalOGraphQLNode2ᚕᚖgithubᚗcomᚋpoktᚑfoundationᚋpocketᚑindexerᚑservicesᚋapiᚋgraphᚋmodelᚐGraphQLNode(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext_NodesResponse_nodes(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "NodesResponse",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			switch field.Name {
+			case "address":
+				return ec.fieldContext_GraphQLNode_address(ctx, field)
+			case "height":
+				return ec.fieldContext_GraphQLNode_height(ctx, field)
+			case "jailed":
+				return ec.fieldContext_GraphQLNode_jailed(ctx, field)
+			case "publicKey":
+				return ec.fieldContext_GraphQLNode_publicKey(ctx, field)
+			case "serviceURL":
+				return ec.fieldContext_GraphQLNode_serviceURL(ctx, field)
+			case "tokens":
+				return ec.fieldContext_GraphQLNode_tokens(ctx, field)
+			case "account":
+				return ec.fieldContext_GraphQLNode_account(ctx, field)
+			case "transactions":
+				return ec.fieldContext_GraphQLNode_transactions(ctx, field)
 			}
-			return nil, fmt.Errorf("no field named %q was found under type TxResult", field.Name)
+			return nil, fmt.Errorf("no field named %q was found under type GraphQLNode", field.Name)
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _GraphQLTransaction_tx(ctx context.Context, field graphql.CollectedField, obj *model.GraphQLTransaction) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_GraphQLTransaction_tx(ctx, field)
+func (ec *executionContext) _NodesResponse_totalCount(ctx context.Context, field graphql.CollectedField, obj *model.NodesResponse) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_NodesResponse_totalCount(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -3737,7 +6198,7 @@ func (ec *executionContext) _GraphQLTransaction_tx(ctx context.Context, field gr
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.Tx, nil
+		return obj.TotalCount, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -3749,26 +6210,26 @@ func (ec *executionContext) _GraphQLTransaction_tx(ctx context.Context, field gr
 		}
 		return graphql.Null
 	}
-	res := resTmp.(string)
+	res := resTmp.(int)
 	fc.Result = res
-	return ec.marshalNString2string(ctx, field.Selections, res)
+	return ec.marshalNInt2int(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_GraphQLTransaction_tx(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_NodesResponse_totalCount(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "GraphQLTransaction",
+		Object:     "NodesResponse",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type String does not have child fields")
+			return nil, errors.New("field of type Int does not have child fields")
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _GraphQLTransaction_entropy(ctx context.Context, field graphql.CollectedField, obj *model.GraphQLTransaction) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_GraphQLTransaction_entropy(ctx, field)
+func (ec *executionContext) _NodesResponse_pageCount(ctx context.Context, field graphql.CollectedField, obj *model.NodesResponse) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_NodesResponse_pageCount(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -3781,7 +6242,7 @@ func (ec *executionContext) _GraphQLTransaction_entropy(ctx context.Context, fie
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.Entropy, nil
+		return obj.PageCount, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -3793,26 +6254,26 @@ func (ec *executionContext) _GraphQLTransaction_entropy(ctx context.Context, fie
 		}
 		return graphql.Null
 	}
-	res := resTmp.(string)
+	res := resTmp.(int)
 	fc.Result = res
-	return ec.marshalNString2string(ctx, field.Selections, res)
+	return ec.marshalNInt2int(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_GraphQLTransaction_entropy(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_NodesResponse_pageCount(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "GraphQLTransaction",
+		Object:     "NodesResponse",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type String does not have child fields")
+			return nil, errors.New("field of type Int does not have child fields")
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _GraphQLTransaction_fee(ctx context.Context, field graphql.CollectedField, obj *model.GraphQLTransaction) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_GraphQLTransaction_fee(ctx, field)
+func (ec *executionContext) _NodesResponse_page(ctx context.Context, field graphql.CollectedField, obj *model.NodesResponse) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_NodesResponse_page(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -3825,7 +6286,7 @@ func (ec *executionContext) _GraphQLTransaction_fee(ctx context.Context, field g
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.Fee, nil
+		return obj.Page, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -3842,9 +6303,9 @@ func (ec *executionContext) _GraphQLTransaction_fee(ctx context.Context, field g
 	return ec.marshalNInt2int(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_GraphQLTransaction_fee(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_NodesResponse_page(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "GraphQLTransaction",
+		Object:     "NodesResponse",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
@@ -3855,8 +6316,8 @@ func (ec *executionContext) fieldContext_GraphQLTransaction_fee(ctx context.Cont
 	return fc, nil
 }
 
-func (ec *executionContext) _GraphQLTransaction_feeDenomination(ctx context.Context, field graphql.CollectedField, obj *model.GraphQLTransaction) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_GraphQLTransaction_feeDenomination(ctx, field)
+func (ec *executionContext) _NodesResponse_totalPages(ctx context.Context, field graphql.CollectedField, obj *model.NodesResponse) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_NodesResponse_totalPages(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -3869,7 +6330,7 @@ func (ec *executionContext) _GraphQLTransaction_feeDenomination(ctx context.Cont
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.FeeDenomination, nil
+		return obj.TotalPages, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -3881,26 +6342,26 @@ func (ec *executionContext) _GraphQLTransaction_feeDenomination(ctx context.Cont
 		}
 		return graphql.Null
 	}
-	res := resTmp.(string)
+	res := resTmp.(int)
 	fc.Result = res
-	return ec.marshalNString2string(ctx, field.Selections, res)
+	return ec.marshalNInt2int(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_GraphQLTransaction_feeDenomination(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_NodesResponse_totalPages(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "GraphQLTransaction",
+		Object:     "NodesResponse",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type String does not have child fields")
+			return nil, errors.New("field of type Int does not have child fields")
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _GraphQLTransaction_amount(ctx context.Context, field graphql.CollectedField, obj *model.GraphQLTransaction) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_GraphQLTransaction_amount(ctx, field)
+func (ec *executionContext) _NodesResponse_edges(ctx context.Context, field graphql.CollectedField, obj *model.NodesResponse) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_NodesResponse_edges(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -3913,7 +6374,7 @@ func (ec *executionContext) _GraphQLTransaction_amount(ctx context.Context, fiel
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.Amount, nil
+		return obj.Edges, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -3925,26 +6386,32 @@ func (ec *executionContext) _GraphQLTransaction_amount(ctx context.Context, fiel
 		}
 		return graphql.Null
 	}
-	res := resTmp.(string)
+	res := resTmp.([]*model.NodeEdge)
 	fc.Result = res
-	return ec.marshalNString2string(ctx, field.Selections, res)
+	return ec.marshalNNodeEdge2ᚕᚖgithubᚗcomᚋpoktᚑfoundationᚋpocketᚑindexerᚑservicesᚋapiᚋgraphᚋmodelᚐNodeEdgeᚄ(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_GraphQLTransaction_amount(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_NodesResponse_edges(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "GraphQLTransaction",
+		Object:     "NodesResponse",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type String does not have child fields")
+			switch field.Name {
+			case "cursor":
+				return ec.fieldContext_NodeEdge_cursor(ctx, field)
+			case "node":
+				return ec.fieldContext_NodeEdge_node(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type NodeEdge", field.Name)
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _NodesResponse_nodes(ctx context.Context, field graphql.CollectedField, obj *model.NodesResponse) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_NodesResponse_nodes(ctx, field)
+func (ec *executionContext) _NodesResponse_pageInfo(ctx context.Context, field graphql.CollectedField, obj *model.NodesResponse) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_NodesResponse_pageInfo(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -3957,21 +6424,24 @@ func (ec *executionContext) _NodesResponse_nodes(ctx context.Context, field grap
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.Nodes, nil
+		return obj.PageInfo, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
 		return graphql.Null
 	}
 	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
 		return graphql.Null
 	}
-	res := resTmp.([]*model.GraphQLNode)
+	res := resTmp.(*model.PageInfo)
 	fc.Result = res
-	return ec.marshalOGraphQLNode2ᚕᚖgithubᚗcomᚋpoktᚑfoundationᚋpocketᚑindexerᚑservicesᚋapiᚋgraphᚋmodelᚐGraphQLNode(ctx, field.Selections, res)
+	return ec.marshalNPageInfo2ᚖgithubᚗcomᚋpoktᚑfoundationᚋpocketᚑindexerᚑservicesᚋapiᚋgraphᚋmodelᚐPageInfo(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_NodesResponse_nodes(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_NodesResponse_pageInfo(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
 		Object:     "NodesResponse",
 		Field:      field,
@@ -3979,27 +6449,23 @@ func (ec *executionContext) fieldContext_NodesResponse_nodes(ctx context.Context
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
 			switch field.Name {
-			case "address":
-				return ec.fieldContext_GraphQLNode_address(ctx, field)
-			case "height":
-				return ec.fieldContext_GraphQLNode_height(ctx, field)
-			case "jailed":
-				return ec.fieldContext_GraphQLNode_jailed(ctx, field)
-			case "publicKey":
-				return ec.fieldContext_GraphQLNode_publicKey(ctx, field)
-			case "serviceURL":
-				return ec.fieldContext_GraphQLNode_serviceURL(ctx, field)
-			case "tokens":
-				return ec.fieldContext_GraphQLNode_tokens(ctx, field)
+			case "hasNextPage":
+				return ec.fieldContext_PageInfo_hasNextPage(ctx, field)
+			case "hasPreviousPage":
+				return ec.fieldContext_PageInfo_hasPreviousPage(ctx, field)
+			case "startCursor":
+				return ec.fieldContext_PageInfo_startCursor(ctx, field)
+			case "endCursor":
+				return ec.fieldContext_PageInfo_endCursor(ctx, field)
 			}
-			return nil, fmt.Errorf("no field named %q was found under type GraphQLNode", field.Name)
+			return nil, fmt.Errorf("no field named %q was found under type PageInfo", field.Name)
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _NodesResponse_totalCount(ctx context.Context, field graphql.CollectedField, obj *model.NodesResponse) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_NodesResponse_totalCount(ctx, field)
+func (ec *executionContext) _PageInfo_hasNextPage(ctx context.Context, field graphql.CollectedField, obj *model.PageInfo) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_PageInfo_hasNextPage(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -4012,7 +6478,7 @@ func (ec *executionContext) _NodesResponse_totalCount(ctx context.Context, field
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.TotalCount, nil
+		return obj.HasNextPage, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -4024,26 +6490,26 @@ func (ec *executionContext) _NodesResponse_totalCount(ctx context.Context, field
 		}
 		return graphql.Null
 	}
-	res := resTmp.(int)
+	res := resTmp.(bool)
 	fc.Result = res
-	return ec.marshalNInt2int(ctx, field.Selections, res)
+	return ec.marshalNBoolean2bool(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_NodesResponse_totalCount(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_PageInfo_hasNextPage(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "NodesResponse",
+		Object:     "PageInfo",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type Int does not have child fields")
+			return nil, errors.New("field of type Boolean does not have child fields")
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _NodesResponse_pageCount(ctx context.Context, field graphql.CollectedField, obj *model.NodesResponse) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_NodesResponse_pageCount(ctx, field)
+func (ec *executionContext) _PageInfo_hasPreviousPage(ctx context.Context, field graphql.CollectedField, obj *model.PageInfo) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_PageInfo_hasPreviousPage(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -4056,7 +6522,7 @@ func (ec *executionContext) _NodesResponse_pageCount(ctx context.Context, field
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.PageCount, nil
+		return obj.HasPreviousPage, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -4068,26 +6534,26 @@ func (ec *executionContext) _NodesResponse_pageCount(ctx context.Context, field
 		}
 		return graphql.Null
 	}
-	res := resTmp.(int)
+	res := resTmp.(bool)
 	fc.Result = res
-	return ec.marshalNInt2int(ctx, field.Selections, res)
+	return ec.marshalNBoolean2bool(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_NodesResponse_pageCount(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_PageInfo_hasPreviousPage(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "NodesResponse",
+		Object:     "PageInfo",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type Int does not have child fields")
+			return nil, errors.New("field of type Boolean does not have child fields")
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _NodesResponse_page(ctx context.Context, field graphql.CollectedField, obj *model.NodesResponse) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_NodesResponse_page(ctx, field)
+func (ec *executionContext) _PageInfo_startCursor(ctx context.Context, field graphql.CollectedField, obj *model.PageInfo) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_PageInfo_startCursor(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -4100,38 +6566,35 @@ func (ec *executionContext) _NodesResponse_page(ctx context.Context, field graph
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.Page, nil
+		return obj.StartCursor, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
 		return graphql.Null
 	}
 	if resTmp == nil {
-		if !graphql.HasFieldError(ctx, fc) {
-			ec.Errorf(ctx, "must not be null")
-		}
 		return graphql.Null
 	}
-	res := resTmp.(int)
+	res := resTmp.(*string)
 	fc.Result = res
-	return ec.marshalNInt2int(ctx, field.Selections, res)
+	return ec.marshalOString2ᚖstring(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_NodesResponse_page(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_PageInfo_startCursor(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "NodesResponse",
+		Object:     "PageInfo",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type Int does not have child fields")
+			return nil, errors.New("field of type String does not have child fields")
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _NodesResponse_totalPages(ctx context.Context, field graphql.CollectedField, obj *model.NodesResponse) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_NodesResponse_totalPages(ctx, field)
+func (ec *executionContext) _PageInfo_endCursor(ctx context.Context, field graphql.CollectedField, obj *model.PageInfo) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_PageInfo_endCursor(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -4144,31 +6607,28 @@ func (ec *executionContext) _NodesResponse_totalPages(ctx context.Context, field
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.TotalPages, nil
+		return obj.EndCursor, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
 		return graphql.Null
 	}
 	if resTmp == nil {
-		if !graphql.HasFieldError(ctx, fc) {
-			ec.Errorf(ctx, "must not be null")
-		}
 		return graphql.Null
 	}
-	res := resTmp.(int)
+	res := resTmp.(*string)
 	fc.Result = res
-	return ec.marshalNInt2int(ctx, field.Selections, res)
+	return ec.marshalOString2ᚖstring(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_NodesResponse_totalPages(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_PageInfo_endCursor(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "NodesResponse",
+		Object:     "PageInfo",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type Int does not have child fields")
+			return nil, errors.New("field of type String does not have child fields")
 		},
 	}
 	return fc, nil
@@ -4220,6 +6680,8 @@ func (ec *executionContext) fieldContext_Query_queryBlockByHash(ctx context.Cont
 				return ec.fieldContext_Block_proposerAddress(ctx, field)
 			case "txCount":
 				return ec.fieldContext_Block_txCount(ctx, field)
+			case "transactions":
+				return ec.fieldContext_Block_transactions(ctx, field)
 			}
 			return nil, fmt.Errorf("no field named %q was found under type Block", field.Name)
 		},
@@ -4284,6 +6746,8 @@ func (ec *executionContext) fieldContext_Query_queryBlockByHeight(ctx context.Co
 				return ec.fieldContext_Block_proposerAddress(ctx, field)
 			case "txCount":
 				return ec.fieldContext_Block_txCount(ctx, field)
+			case "transactions":
+				return ec.fieldContext_Block_transactions(ctx, field)
 			}
 			return nil, fmt.Errorf("no field named %q was found under type Block", field.Name)
 		},
@@ -4316,7 +6780,7 @@ func (ec *executionContext) _Query_queryBlocks(ctx context.Context, field graphq
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return ec.resolvers.Query().QueryBlocks(rctx, fc.Args["page"].(*int), fc.Args["perPage"].(*int), fc.Args["order"].(*postgresdriver.Order))
+		return ec.resolvers.Query().QueryBlocks(rctx, fc.Args["page"].(*int), fc.Args["perPage"].(*int), fc.Args["order"].(*postgresdriver.Order), fc.Args["first"].(*int), fc.Args["after"].(*string), fc.Args["last"].(*int), fc.Args["before"].(*string))
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -4348,6 +6812,10 @@ func (ec *executionContext) fieldContext_Query_queryBlocks(ctx context.Context,
 				return ec.fieldContext_BlocksResponse_page(ctx, field)
 			case "totalPages":
 				return ec.fieldContext_BlocksResponse_totalPages(ctx, field)
+			case "edges":
+				return ec.fieldContext_BlocksResponse_edges(ctx, field)
+			case "pageInfo":
+				return ec.fieldContext_BlocksResponse_pageInfo(ctx, field)
 			}
 			return nil, fmt.Errorf("no field named %q was found under type BlocksResponse", field.Name)
 		},
@@ -4432,6 +6900,12 @@ func (ec *executionContext) fieldContext_Query_queryTransactionByHash(ctx contex
 				return ec.fieldContext_GraphQLTransaction_feeDenomination(ctx, field)
 			case "amount":
 				return ec.fieldContext_GraphQLTransaction_amount(ctx, field)
+			case "block":
+				return ec.fieldContext_GraphQLTransaction_block(ctx, field)
+			case "fromAccount":
+				return ec.fieldContext_GraphQLTransaction_fromAccount(ctx, field)
+			case "toAccount":
+				return ec.fieldContext_GraphQLTransaction_toAccount(ctx, field)
 			}
 			return nil, fmt.Errorf("no field named %q was found under type GraphQLTransaction", field.Name)
 		},
@@ -4464,7 +6938,7 @@ func (ec *executionContext) _Query_queryTransactionsByHeight(ctx context.Context
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return ec.resolvers.Query().QueryTransactionsByHeight(rctx, fc.Args["height"].(int), fc.Args["page"].(*int), fc.Args["perPage"].(*int))
+		return ec.resolvers.Query().QueryTransactionsByHeight(rctx, fc.Args["height"].(int), fc.Args["page"].(*int), fc.Args["perPage"].(*int), fc.Args["first"].(*int), fc.Args["after"].(*string), fc.Args["last"].(*int), fc.Args["before"].(*string))
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -4496,6 +6970,10 @@ func (ec *executionContext) fieldContext_Query_queryTransactionsByHeight(ctx con
 				return ec.fieldContext_TransactionsResponse_page(ctx, field)
 			case "totalPages":
 				return ec.fieldContext_TransactionsResponse_totalPages(ctx, field)
+			case "edges":
+				return ec.fieldContext_TransactionsResponse_edges(ctx, field)
+			case "pageInfo":
+				return ec.fieldContext_TransactionsResponse_pageInfo(ctx, field)
 			}
 			return nil, fmt.Errorf("no field named %q was found under type TransactionsResponse", field.Name)
 		},
@@ -4528,7 +7006,7 @@ func (ec *executionContext) _Query_queryTransactions(ctx context.Context, field
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return ec.resolvers.Query().QueryTransactions(rctx, fc.Args["page"].(*int), fc.Args["perPage"].(*int), fc.Args["order"].(*postgresdriver.Order))
+		return ec.resolvers.Query().QueryTransactions(rctx, fc.Args["page"].(*int), fc.Args["perPage"].(*int), fc.Args["order"].(*postgresdriver.Order), fc.Args["filter"].(*model.TransactionFilter), fc.Args["first"].(*int), fc.Args["after"].(*string), fc.Args["last"].(*int), fc.Args["before"].(*string))
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -4560,6 +7038,10 @@ func (ec *executionContext) fieldContext_Query_queryTransactions(ctx context.Con
 				return ec.fieldContext_TransactionsResponse_page(ctx, field)
 			case "totalPages":
 				return ec.fieldContext_TransactionsResponse_totalPages(ctx, field)
+			case "edges":
+				return ec.fieldContext_TransactionsResponse_edges(ctx, field)
+			case "pageInfo":
+				return ec.fieldContext_TransactionsResponse_pageInfo(ctx, field)
 			}
 			return nil, fmt.Errorf("no field named %q was found under type TransactionsResponse", field.Name)
 		},
@@ -4592,7 +7074,7 @@ func (ec *executionContext) _Query_queryTransactionsByAddress(ctx context.Contex
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return ec.resolvers.Query().QueryTransactionsByAddress(rctx, fc.Args["address"].(string), fc.Args["page"].(*int), fc.Args["perPage"].(*int))
+		return ec.resolvers.Query().QueryTransactionsByAddress(rctx, fc.Args["address"].(string), fc.Args["page"].(*int), fc.Args["perPage"].(*int), fc.Args["order"].(*postgresdriver.Order), fc.Args["filter"].(*model.TransactionFilter), fc.Args["first"].(*int), fc.Args["after"].(*string), fc.Args["last"].(*int), fc.Args["before"].(*string))
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -4624,6 +7106,10 @@ func (ec *executionContext) fieldContext_Query_queryTransactionsByAddress(ctx co
 				return ec.fieldContext_TransactionsResponse_page(ctx, field)
 			case "totalPages":
 				return ec.fieldContext_TransactionsResponse_totalPages(ctx, field)
+			case "edges":
+				return ec.fieldContext_TransactionsResponse_edges(ctx, field)
+			case "pageInfo":
+				return ec.fieldContext_TransactionsResponse_pageInfo(ctx, field)
 			}
 			return nil, fmt.Errorf("no field named %q was found under type TransactionsResponse", field.Name)
 		},
@@ -4720,7 +7206,7 @@ func (ec *executionContext) _Query_queryAccounts(ctx context.Context, field grap
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return ec.resolvers.Query().QueryAccounts(rctx, fc.Args["height"].(*int), fc.Args["page"].(*int), fc.Args["perPage"].(*int))
+		return ec.resolvers.Query().QueryAccounts(rctx, fc.Args["height"].(*int), fc.Args["page"].(*int), fc.Args["perPage"].(*int), fc.Args["first"].(*int), fc.Args["after"].(*string), fc.Args["last"].(*int), fc.Args["before"].(*string))
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -4752,6 +7238,10 @@ func (ec *executionContext) fieldContext_Query_queryAccounts(ctx context.Context
 				return ec.fieldContext_AccountsResponse_page(ctx, field)
 			case "totalPages":
 				return ec.fieldContext_AccountsResponse_totalPages(ctx, field)
+			case "edges":
+				return ec.fieldContext_AccountsResponse_edges(ctx, field)
+			case "pageInfo":
+				return ec.fieldContext_AccountsResponse_pageInfo(ctx, field)
 			}
 			return nil, fmt.Errorf("no field named %q was found under type AccountsResponse", field.Name)
 		},
@@ -4818,6 +7308,10 @@ func (ec *executionContext) fieldContext_Query_queryNodeByAddress(ctx context.Co
 				return ec.fieldContext_GraphQLNode_serviceURL(ctx, field)
 			case "tokens":
 				return ec.fieldContext_GraphQLNode_tokens(ctx, field)
+			case "account":
+				return ec.fieldContext_GraphQLNode_account(ctx, field)
+			case "transactions":
+				return ec.fieldContext_GraphQLNode_transactions(ctx, field)
 			}
 			return nil, fmt.Errorf("no field named %q was found under type GraphQLNode", field.Name)
 		},
@@ -4850,7 +7344,7 @@ func (ec *executionContext) _Query_queryNodes(ctx context.Context, field graphql
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return ec.resolvers.Query().QueryNodes(rctx, fc.Args["height"].(*int), fc.Args["page"].(*int), fc.Args["perPage"].(*int))
+		return ec.resolvers.Query().QueryNodes(rctx, fc.Args["height"].(*int), fc.Args["page"].(*int), fc.Args["perPage"].(*int), fc.Args["first"].(*int), fc.Args["after"].(*string), fc.Args["last"].(*int), fc.Args["before"].(*string))
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -4882,6 +7376,10 @@ func (ec *executionContext) fieldContext_Query_queryNodes(ctx context.Context, f
 				return ec.fieldContext_NodesResponse_page(ctx, field)
 			case "totalPages":
 				return ec.fieldContext_NodesResponse_totalPages(ctx, field)
+			case "edges":
+				return ec.fieldContext_NodesResponse_edges(ctx, field)
+			case "pageInfo":
+				return ec.fieldContext_NodesResponse_pageInfo(ctx, field)
 			}
 			return nil, fmt.Errorf("no field named %q was found under type NodesResponse", field.Name)
 		},
@@ -4946,6 +7444,10 @@ func (ec *executionContext) fieldContext_Query_queryAppByAddress(ctx context.Con
 				return ec.fieldContext_GraphQLApp_publicKey(ctx, field)
 			case "stakedTokens":
 				return ec.fieldContext_GraphQLApp_stakedTokens(ctx, field)
+			case "account":
+				return ec.fieldContext_GraphQLApp_account(ctx, field)
+			case "transactions":
+				return ec.fieldContext_GraphQLApp_transactions(ctx, field)
 			}
 			return nil, fmt.Errorf("no field named %q was found under type GraphQLApp", field.Name)
 		},
@@ -4978,7 +7480,7 @@ func (ec *executionContext) _Query_queryApps(ctx context.Context, field graphql.
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return ec.resolvers.Query().QueryApps(rctx, fc.Args["height"].(*int), fc.Args["page"].(*int), fc.Args["perPage"].(*int))
+		return ec.resolvers.Query().QueryApps(rctx, fc.Args["height"].(*int), fc.Args["page"].(*int), fc.Args["perPage"].(*int), fc.Args["first"].(*int), fc.Args["after"].(*string), fc.Args["last"].(*int), fc.Args["before"].(*string))
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -5010,6 +7512,10 @@ func (ec *executionContext) fieldContext_Query_queryApps(ctx context.Context, fi
 				return ec.fieldContext_AppsResponse_page(ctx, field)
 			case "totalPages":
 				return ec.fieldContext_AppsResponse_totalPages(ctx, field)
+			case "edges":
+				return ec.fieldContext_AppsResponse_edges(ctx, field)
+			case "pageInfo":
+				return ec.fieldContext_AppsResponse_pageInfo(ctx, field)
 			}
 			return nil, fmt.Errorf("no field named %q was found under type AppsResponse", field.Name)
 		},
@@ -5380,7 +7886,133 @@ func (ec *executionContext) fieldContext_StdTx_signature(ctx context.Context, fi
 			case "signature":
 				return ec.fieldContext_TxSignature_signature(ctx, field)
 			}
-			return nil, fmt.Errorf("no field named %q was found under type TxSignature", field.Name)
+			return nil, fmt.Errorf("no field named %q was found under type TxSignature", field.Name)
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _TransactionEdge_cursor(ctx context.Context, field graphql.CollectedField, obj *model.TransactionEdge) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_TransactionEdge_cursor(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Cursor, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(string)
+	fc.Result = res
+	return ec.marshalNString2string(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext_TransactionEdge_cursor(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "TransactionEdge",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type String does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _TransactionEdge_node(ctx context.Context, field graphql.CollectedField, obj *model.TransactionEdge) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_TransactionEdge_node(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Node, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(*model.GraphQLTransaction)
+	fc.Result = res
+	return ec.marshalNGraphQLTransaction2ᚖgithubᚗcomᚋpoktᚑfoundationᚋpocketᚑindexerᚑservicesᚋapiᚋgraphᚋmodelᚐGraphQLTransaction(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext_TransactionEdge_node(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "TransactionEdge",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			switch field.Name {
+			case "hash":
+				return ec.fieldContext_GraphQLTransaction_hash(ctx, field)
+			case "fromAddress":
+				return ec.fieldContext_GraphQLTransaction_fromAddress(ctx, field)
+			case "toAddress":
+				return ec.fieldContext_GraphQLTransaction_toAddress(ctx, field)
+			case "appPubKey":
+				return ec.fieldContext_GraphQLTransaction_appPubKey(ctx, field)
+			case "blockchains":
+				return ec.fieldContext_GraphQLTransaction_blockchains(ctx, field)
+			case "messageType":
+				return ec.fieldContext_GraphQLTransaction_messageType(ctx, field)
+			case "height":
+				return ec.fieldContext_GraphQLTransaction_height(ctx, field)
+			case "index":
+				return ec.fieldContext_GraphQLTransaction_index(ctx, field)
+			case "stdTx":
+				return ec.fieldContext_GraphQLTransaction_stdTx(ctx, field)
+			case "txResult":
+				return ec.fieldContext_GraphQLTransaction_txResult(ctx, field)
+			case "tx":
+				return ec.fieldContext_GraphQLTransaction_tx(ctx, field)
+			case "entropy":
+				return ec.fieldContext_GraphQLTransaction_entropy(ctx, field)
+			case "fee":
+				return ec.fieldContext_GraphQLTransaction_fee(ctx, field)
+			case "feeDenomination":
+				return ec.fieldContext_GraphQLTransaction_feeDenomination(ctx, field)
+			case "amount":
+				return ec.fieldContext_GraphQLTransaction_amount(ctx, field)
+			case "block":
+				return ec.fieldContext_GraphQLTransaction_block(ctx, field)
+			case "fromAccount":
+				return ec.fieldContext_GraphQLTransaction_fromAccount(ctx, field)
+			case "toAccount":
+				return ec.fieldContext_GraphQLTransaction_toAccount(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type GraphQLTransaction", field.Name)
 		},
 	}
 	return fc, nil
@@ -5452,6 +8084,12 @@ func (ec *executionContext) fieldContext_TransactionsResponse_transactions(ctx c
 				return ec.fieldContext_GraphQLTransaction_feeDenomination(ctx, field)
 			case "amount":
 				return ec.fieldContext_GraphQLTransaction_amount(ctx, field)
+			case "block":
+				return ec.fieldContext_GraphQLTransaction_block(ctx, field)
+			case "fromAccount":
+				return ec.fieldContext_GraphQLTransaction_fromAccount(ctx, field)
+			case "toAccount":
+				return ec.fieldContext_GraphQLTransaction_toAccount(ctx, field)
 			}
 			return nil, fmt.Errorf("no field named %q was found under type GraphQLTransaction", field.Name)
 		},
@@ -5635,6 +8273,110 @@ func (ec *executionContext) fieldContext_TransactionsResponse_totalPages(ctx con
 	return fc, nil
 }
 
+func (ec *executionContext) _TransactionsResponse_edges(ctx context.Context, field graphql.CollectedField, obj *model.TransactionsResponse) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_TransactionsResponse_edges(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Edges, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.([]*model.TransactionEdge)
+	fc.Result = res
+	return ec.marshalNTransactionEdge2ᚕᚖgithubᚗcomᚋpoktᚑfoundationᚋpocketᚑindexerᚑservicesᚋapiᚋgraphᚋmodelᚐTransactionEdgeᚄ(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext_TransactionsResponse_edges(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "TransactionsResponse",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			switch field.Name {
+			case "cursor":
+				return ec.fieldContext_TransactionEdge_cursor(ctx, field)
+			case "node":
+				return ec.fieldContext_TransactionEdge_node(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type TransactionEdge", field.Name)
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _TransactionsResponse_pageInfo(ctx context.Context, field graphql.CollectedField, obj *model.TransactionsResponse) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_TransactionsResponse_pageInfo(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.PageInfo, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(*model.PageInfo)
+	fc.Result = res
+	return ec.marshalNPageInfo2ᚖgithubᚗcomᚋpoktᚑfoundationᚋpocketᚑindexerᚑservicesᚋapiᚋgraphᚋmodelᚐPageInfo(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext_TransactionsResponse_pageInfo(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "TransactionsResponse",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			switch field.Name {
+			case "hasNextPage":
+				return ec.fieldContext_PageInfo_hasNextPage(ctx, field)
+			case "hasPreviousPage":
+				return ec.fieldContext_PageInfo_hasPreviousPage(ctx, field)
+			case "startCursor":
+				return ec.fieldContext_PageInfo_startCursor(ctx, field)
+			case "endCursor":
+				return ec.fieldContext_PageInfo_endCursor(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type PageInfo", field.Name)
+		},
+	}
+	return fc, nil
+}
+
 func (ec *executionContext) _TxMsg_type(ctx context.Context, field graphql.CollectedField, obj *provider.TxMsg) (ret graphql.Marshaler) {
 	fc, err := ec.fieldContext_TxMsg_type(ctx, field)
 	if err != nil {
@@ -7977,6 +10719,117 @@ func (ec *executionContext) fieldContext___Type_specifiedByURL(ctx context.Conte
 
 // region    **************************** input.gotpl *****************************
 
+func (ec *executionContext) unmarshalInputTransactionFilter(ctx context.Context, obj interface{}) (model.TransactionFilter, error) {
+	var it model.TransactionFilter
+	asMap := map[string]interface{}{}
+	for k, v := range obj.(map[string]interface{}) {
+		asMap[k] = v
+	}
+
+	for k, v := range asMap {
+		switch k {
+		case "messageType":
+			var err error
+
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("messageType"))
+			it.MessageType, err = ec.unmarshalOString2ᚖstring(ctx, v)
+			if err != nil {
+				return it, err
+			}
+		case "blockchains":
+			var err error
+
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("blockchains"))
+			it.Blockchains, err = ec.unmarshalOString2ᚕstringᚄ(ctx, v)
+			if err != nil {
+				return it, err
+			}
+		case "fromHeight":
+			var err error
+
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("fromHeight"))
+			it.FromHeight, err = ec.unmarshalOInt2ᚖint(ctx, v)
+			if err != nil {
+				return it, err
+			}
+		case "toHeight":
+			var err error
+
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("toHeight"))
+			it.ToHeight, err = ec.unmarshalOInt2ᚖint(ctx, v)
+			if err != nil {
+				return it, err
+			}
+		case "fromTime":
+			var err error
+
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("fromTime"))
+			it.FromTime, err = ec.unmarshalOTime2ᚖtimeᚐTime(ctx, v)
+			if err != nil {
+				return it, err
+			}
+		case "toTime":
+			var err error
+
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("toTime"))
+			it.ToTime, err = ec.unmarshalOTime2ᚖtimeᚐTime(ctx, v)
+			if err != nil {
+				return it, err
+			}
+		case "direction":
+			var err error
+
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("direction"))
+			it.Direction, err = ec.unmarshalOTransactionDirection2ᚖgithubᚗcomᚋpoktᚑfoundationᚋpocketᚑindexerᚑservicesᚋapiᚋgraphᚋmodelᚐTransactionDirection(ctx, v)
+			if err != nil {
+				return it, err
+			}
+		case "result":
+			var err error
+
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("result"))
+			it.Result, err = ec.unmarshalOTransactionResult2ᚖgithubᚗcomᚋpoktᚑfoundationᚋpocketᚑindexerᚑservicesᚋapiᚋgraphᚋmodelᚐTransactionResult(ctx, v)
+			if err != nil {
+				return it, err
+			}
+		case "minFee":
+			var err error
+
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("minFee"))
+			it.MinFee, err = ec.unmarshalOInt2ᚖint(ctx, v)
+			if err != nil {
+				return it, err
+			}
+		case "maxFee":
+			var err error
+
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("maxFee"))
+			it.MaxFee, err = ec.unmarshalOInt2ᚖint(ctx, v)
+			if err != nil {
+				return it, err
+			}
+		case "minAmount":
+			var err error
+
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("minAmount"))
+			it.MinAmount, err = ec.unmarshalOString2ᚖstring(ctx, v)
+			if err != nil {
+				return it, err
+			}
+		case "maxAmount":
+			var err error
+
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("maxAmount"))
+			it.MaxAmount, err = ec.unmarshalOString2ᚖstring(ctx, v)
+			if err != nil {
+				return it, err
+			}
+		}
+	}
+
+	return it, nil
+}
+
 // endregion **************************** input.gotpl *****************************
 
 // region    ************************** interface.gotpl ***************************
@@ -7985,6 +10838,41 @@ func (ec *executionContext) fieldContext___Type_specifiedByURL(ctx context.Conte
 
 // region    **************************** object.gotpl ****************************
 
+var accountEdgeImplementors = []string{"AccountEdge"}
+
+func (ec *executionContext) _AccountEdge(ctx context.Context, sel ast.SelectionSet, obj *model.AccountEdge) graphql.Marshaler {
+	fields := graphql.CollectFields(ec.OperationContext, sel, accountEdgeImplementors)
+	out := graphql.NewFieldSet(fields)
+	var invalids uint32
+	for i, field := range fields {
+		switch field.Name {
+		case "__typename":
+			out.Values[i] = graphql.MarshalString("AccountEdge")
+		case "cursor":
+
+			out.Values[i] = ec._AccountEdge_cursor(ctx, field, obj)
+
+			if out.Values[i] == graphql.Null {
+				invalids++
+			}
+		case "node":
+
+			out.Values[i] = ec._AccountEdge_node(ctx, field, obj)
+
+			if out.Values[i] == graphql.Null {
+				invalids++
+			}
+		default:
+			panic("unknown field " + strconv.Quote(field.Name))
+		}
+	}
+	out.Dispatch()
+	if invalids > 0 {
+		return graphql.Null
+	}
+	return out
+}
+
 var accountsResponseImplementors = []string{"AccountsResponse"}
 
 func (ec *executionContext) _AccountsResponse(ctx context.Context, sel ast.SelectionSet, obj *model.AccountsResponse) graphql.Marshaler {
@@ -8024,6 +10912,55 @@ func (ec *executionContext) _AccountsResponse(ctx context.Context, sel ast.Selec
 
 			out.Values[i] = ec._AccountsResponse_totalPages(ctx, field, obj)
 
+			if out.Values[i] == graphql.Null {
+				invalids++
+			}
+		case "edges":
+
+			out.Values[i] = ec._AccountsResponse_edges(ctx, field, obj)
+
+			if out.Values[i] == graphql.Null {
+				invalids++
+			}
+		case "pageInfo":
+
+			out.Values[i] = ec._AccountsResponse_pageInfo(ctx, field, obj)
+
+			if out.Values[i] == graphql.Null {
+				invalids++
+			}
+		default:
+			panic("unknown field " + strconv.Quote(field.Name))
+		}
+	}
+	out.Dispatch()
+	if invalids > 0 {
+		return graphql.Null
+	}
+	return out
+}
+
+var appEdgeImplementors = []string{"AppEdge"}
+
+func (ec *executionContext) _AppEdge(ctx context.Context, sel ast.SelectionSet, obj *model.AppEdge) graphql.Marshaler {
+	fields := graphql.CollectFields(ec.OperationContext, sel, appEdgeImplementors)
+	out := graphql.NewFieldSet(fields)
+	var invalids uint32
+	for i, field := range fields {
+		switch field.Name {
+		case "__typename":
+			out.Values[i] = graphql.MarshalString("AppEdge")
+		case "cursor":
+
+			out.Values[i] = ec._AppEdge_cursor(ctx, field, obj)
+
+			if out.Values[i] == graphql.Null {
+				invalids++
+			}
+		case "node":
+
+			out.Values[i] = ec._AppEdge_node(ctx, field, obj)
+
 			if out.Values[i] == graphql.Null {
 				invalids++
 			}
@@ -8077,6 +11014,20 @@ func (ec *executionContext) _AppsResponse(ctx context.Context, sel ast.Selection
 
 			out.Values[i] = ec._AppsResponse_totalPages(ctx, field, obj)
 
+			if out.Values[i] == graphql.Null {
+				invalids++
+			}
+		case "edges":
+
+			out.Values[i] = ec._AppsResponse_edges(ctx, field, obj)
+
+			if out.Values[i] == graphql.Null {
+				invalids++
+			}
+		case "pageInfo":
+
+			out.Values[i] = ec._AppsResponse_pageInfo(ctx, field, obj)
+
 			if out.Values[i] == graphql.Null {
 				invalids++
 			}
@@ -8106,32 +11057,84 @@ func (ec *executionContext) _Block(ctx context.Context, sel ast.SelectionSet, ob
 			out.Values[i] = ec._Block_hash(ctx, field, obj)
 
 			if out.Values[i] == graphql.Null {
-				invalids++
+				atomic.AddUint32(&invalids, 1)
 			}
 		case "height":
 
 			out.Values[i] = ec._Block_height(ctx, field, obj)
 
 			if out.Values[i] == graphql.Null {
-				invalids++
+				atomic.AddUint32(&invalids, 1)
 			}
 		case "time":
 
 			out.Values[i] = ec._Block_time(ctx, field, obj)
 
 			if out.Values[i] == graphql.Null {
-				invalids++
+				atomic.AddUint32(&invalids, 1)
 			}
 		case "proposerAddress":
 
-			out.Values[i] = ec._Block_proposerAddress(ctx, field, obj)
+			out.Values[i] = ec._Block_proposerAddress(ctx, field, obj)
+
+			if out.Values[i] == graphql.Null {
+				atomic.AddUint32(&invalids, 1)
+			}
+		case "txCount":
+
+			out.Values[i] = ec._Block_txCount(ctx, field, obj)
+
+			if out.Values[i] == graphql.Null {
+				atomic.AddUint32(&invalids, 1)
+			}
+		case "transactions":
+			field := field
+
+			innerFunc := func(ctx context.Context) (res graphql.Marshaler) {
+				defer func() {
+					if r := recover(); r != nil {
+						ec.Error(ctx, ec.Recover(ctx, r))
+					}
+				}()
+				res = ec._Block_transactions(ctx, field, obj)
+				return res
+			}
+
+			out.Concurrently(i, func() graphql.Marshaler {
+				return innerFunc(ctx)
+
+			})
+		default:
+			panic("unknown field " + strconv.Quote(field.Name))
+		}
+	}
+	out.Dispatch()
+	if invalids > 0 {
+		return graphql.Null
+	}
+	return out
+}
+
+var blockEdgeImplementors = []string{"BlockEdge"}
+
+func (ec *executionContext) _BlockEdge(ctx context.Context, sel ast.SelectionSet, obj *model.BlockEdge) graphql.Marshaler {
+	fields := graphql.CollectFields(ec.OperationContext, sel, blockEdgeImplementors)
+	out := graphql.NewFieldSet(fields)
+	var invalids uint32
+	for i, field := range fields {
+		switch field.Name {
+		case "__typename":
+			out.Values[i] = graphql.MarshalString("BlockEdge")
+		case "cursor":
+
+			out.Values[i] = ec._BlockEdge_cursor(ctx, field, obj)
 
 			if out.Values[i] == graphql.Null {
 				invalids++
 			}
-		case "txCount":
+		case "node":
 
-			out.Values[i] = ec._Block_txCount(ctx, field, obj)
+			out.Values[i] = ec._BlockEdge_node(ctx, field, obj)
 
 			if out.Values[i] == graphql.Null {
 				invalids++
@@ -8186,6 +11189,20 @@ func (ec *executionContext) _BlocksResponse(ctx context.Context, sel ast.Selecti
 
 			out.Values[i] = ec._BlocksResponse_totalPages(ctx, field, obj)
 
+			if out.Values[i] == graphql.Null {
+				invalids++
+			}
+		case "edges":
+
+			out.Values[i] = ec._BlocksResponse_edges(ctx, field, obj)
+
+			if out.Values[i] == graphql.Null {
+				invalids++
+			}
+		case "pageInfo":
+
+			out.Values[i] = ec._BlocksResponse_pageInfo(ctx, field, obj)
+
 			if out.Values[i] == graphql.Null {
 				invalids++
 			}
@@ -8306,36 +11323,70 @@ func (ec *executionContext) _GraphQLApp(ctx context.Context, sel ast.SelectionSe
 			out.Values[i] = ec._GraphQLApp_address(ctx, field, obj)
 
 			if out.Values[i] == graphql.Null {
-				invalids++
+				atomic.AddUint32(&invalids, 1)
 			}
 		case "height":
 
 			out.Values[i] = ec._GraphQLApp_height(ctx, field, obj)
 
 			if out.Values[i] == graphql.Null {
-				invalids++
+				atomic.AddUint32(&invalids, 1)
 			}
 		case "jailed":
 
 			out.Values[i] = ec._GraphQLApp_jailed(ctx, field, obj)
 
 			if out.Values[i] == graphql.Null {
-				invalids++
+				atomic.AddUint32(&invalids, 1)
 			}
 		case "publicKey":
 
 			out.Values[i] = ec._GraphQLApp_publicKey(ctx, field, obj)
 
 			if out.Values[i] == graphql.Null {
-				invalids++
+				atomic.AddUint32(&invalids, 1)
 			}
 		case "stakedTokens":
 
 			out.Values[i] = ec._GraphQLApp_stakedTokens(ctx, field, obj)
 
 			if out.Values[i] == graphql.Null {
-				invalids++
+				atomic.AddUint32(&invalids, 1)
+			}
+		case "account":
+			field := field
+
+			innerFunc := func(ctx context.Context) (res graphql.Marshaler) {
+				defer func() {
+					if r := recover(); r != nil {
+						ec.Error(ctx, ec.Recover(ctx, r))
+					}
+				}()
+				res = ec._GraphQLApp_account(ctx, field, obj)
+				return res
+			}
+
+			out.Concurrently(i, func() graphql.Marshaler {
+				return innerFunc(ctx)
+
+			})
+		case "transactions":
+			field := field
+
+			innerFunc := func(ctx context.Context) (res graphql.Marshaler) {
+				defer func() {
+					if r := recover(); r != nil {
+						ec.Error(ctx, ec.Recover(ctx, r))
+					}
+				}()
+				res = ec._GraphQLApp_transactions(ctx, field, obj)
+				return res
 			}
+
+			out.Concurrently(i, func() graphql.Marshaler {
+				return innerFunc(ctx)
+
+			})
 		default:
 			panic("unknown field " + strconv.Quote(field.Name))
 		}
@@ -8362,39 +11413,276 @@ func (ec *executionContext) _GraphQLNode(ctx context.Context, sel ast.SelectionS
 			out.Values[i] = ec._GraphQLNode_address(ctx, field, obj)
 
 			if out.Values[i] == graphql.Null {
-				invalids++
+				atomic.AddUint32(&invalids, 1)
 			}
 		case "height":
 
 			out.Values[i] = ec._GraphQLNode_height(ctx, field, obj)
 
 			if out.Values[i] == graphql.Null {
-				invalids++
+				atomic.AddUint32(&invalids, 1)
 			}
 		case "jailed":
 
 			out.Values[i] = ec._GraphQLNode_jailed(ctx, field, obj)
 
 			if out.Values[i] == graphql.Null {
-				invalids++
+				atomic.AddUint32(&invalids, 1)
 			}
 		case "publicKey":
 
 			out.Values[i] = ec._GraphQLNode_publicKey(ctx, field, obj)
 
 			if out.Values[i] == graphql.Null {
-				invalids++
+				atomic.AddUint32(&invalids, 1)
 			}
 		case "serviceURL":
 
 			out.Values[i] = ec._GraphQLNode_serviceURL(ctx, field, obj)
 
 			if out.Values[i] == graphql.Null {
-				invalids++
+				atomic.AddUint32(&invalids, 1)
 			}
 		case "tokens":
 
-			out.Values[i] = ec._GraphQLNode_tokens(ctx, field, obj)
+			out.Values[i] = ec._GraphQLNode_tokens(ctx, field, obj)
+
+			if out.Values[i] == graphql.Null {
+				atomic.AddUint32(&invalids, 1)
+			}
+		case "account":
+			field := field
+
+			innerFunc := func(ctx context.Context) (res graphql.Marshaler) {
+				defer func() {
+					if r := recover(); r != nil {
+						ec.Error(ctx, ec.Recover(ctx, r))
+					}
+				}()
+				res = ec._GraphQLNode_account(ctx, field, obj)
+				return res
+			}
+
+			out.Concurrently(i, func() graphql.Marshaler {
+				return innerFunc(ctx)
+
+			})
+		case "transactions":
+			field := field
+
+			innerFunc := func(ctx context.Context) (res graphql.Marshaler) {
+				defer func() {
+					if r := recover(); r != nil {
+						ec.Error(ctx, ec.Recover(ctx, r))
+					}
+				}()
+				res = ec._GraphQLNode_transactions(ctx, field, obj)
+				return res
+			}
+
+			out.Concurrently(i, func() graphql.Marshaler {
+				return innerFunc(ctx)
+
+			})
+		default:
+			panic("unknown field " + strconv.Quote(field.Name))
+		}
+	}
+	out.Dispatch()
+	if invalids > 0 {
+		return graphql.Null
+	}
+	return out
+}
+
+var graphQLTransactionImplementors = []string{"GraphQLTransaction"}
+
+func (ec *executionContext) _GraphQLTransaction(ctx context.Context, sel ast.SelectionSet, obj *model.GraphQLTransaction) graphql.Marshaler {
+	fields := graphql.CollectFields(ec.OperationContext, sel, graphQLTransactionImplementors)
+	out := graphql.NewFieldSet(fields)
+	var invalids uint32
+	for i, field := range fields {
+		switch field.Name {
+		case "__typename":
+			out.Values[i] = graphql.MarshalString("GraphQLTransaction")
+		case "hash":
+
+			out.Values[i] = ec._GraphQLTransaction_hash(ctx, field, obj)
+
+			if out.Values[i] == graphql.Null {
+				atomic.AddUint32(&invalids, 1)
+			}
+		case "fromAddress":
+
+			out.Values[i] = ec._GraphQLTransaction_fromAddress(ctx, field, obj)
+
+			if out.Values[i] == graphql.Null {
+				atomic.AddUint32(&invalids, 1)
+			}
+		case "toAddress":
+
+			out.Values[i] = ec._GraphQLTransaction_toAddress(ctx, field, obj)
+
+			if out.Values[i] == graphql.Null {
+				atomic.AddUint32(&invalids, 1)
+			}
+		case "appPubKey":
+
+			out.Values[i] = ec._GraphQLTransaction_appPubKey(ctx, field, obj)
+
+			if out.Values[i] == graphql.Null {
+				atomic.AddUint32(&invalids, 1)
+			}
+		case "blockchains":
+
+			out.Values[i] = ec._GraphQLTransaction_blockchains(ctx, field, obj)
+
+		case "messageType":
+
+			out.Values[i] = ec._GraphQLTransaction_messageType(ctx, field, obj)
+
+			if out.Values[i] == graphql.Null {
+				atomic.AddUint32(&invalids, 1)
+			}
+		case "height":
+
+			out.Values[i] = ec._GraphQLTransaction_height(ctx, field, obj)
+
+			if out.Values[i] == graphql.Null {
+				atomic.AddUint32(&invalids, 1)
+			}
+		case "index":
+
+			out.Values[i] = ec._GraphQLTransaction_index(ctx, field, obj)
+
+			if out.Values[i] == graphql.Null {
+				atomic.AddUint32(&invalids, 1)
+			}
+		case "stdTx":
+
+			out.Values[i] = ec._GraphQLTransaction_stdTx(ctx, field, obj)
+
+		case "txResult":
+
+			out.Values[i] = ec._GraphQLTransaction_txResult(ctx, field, obj)
+
+		case "tx":
+
+			out.Values[i] = ec._GraphQLTransaction_tx(ctx, field, obj)
+
+			if out.Values[i] == graphql.Null {
+				atomic.AddUint32(&invalids, 1)
+			}
+		case "entropy":
+
+			out.Values[i] = ec._GraphQLTransaction_entropy(ctx, field, obj)
+
+			if out.Values[i] == graphql.Null {
+				atomic.AddUint32(&invalids, 1)
+			}
+		case "fee":
+
+			out.Values[i] = ec._GraphQLTransaction_fee(ctx, field, obj)
+
+			if out.Values[i] == graphql.Null {
+				atomic.AddUint32(&invalids, 1)
+			}
+		case "feeDenomination":
+
+			out.Values[i] = ec._GraphQLTransaction_feeDenomination(ctx, field, obj)
+
+			if out.Values[i] == graphql.Null {
+				atomic.AddUint32(&invalids, 1)
+			}
+		case "amount":
+
+			out.Values[i] = ec._GraphQLTransaction_amount(ctx, field, obj)
+
+			if out.Values[i] == graphql.Null {
+				atomic.AddUint32(&invalids, 1)
+			}
+		case "block":
+			field := field
+
+			innerFunc := func(ctx context.Context) (res graphql.Marshaler) {
+				defer func() {
+					if r := recover(); r != nil {
+						ec.Error(ctx, ec.Recover(ctx, r))
+					}
+				}()
+				res = ec._GraphQLTransaction_block(ctx, field, obj)
+				return res
+			}
+
+			out.Concurrently(i, func() graphql.Marshaler {
+				return innerFunc(ctx)
+
+			})
+		case "fromAccount":
+			field := field
+
+			innerFunc := func(ctx context.Context) (res graphql.Marshaler) {
+				defer func() {
+					if r := recover(); r != nil {
+						ec.Error(ctx, ec.Recover(ctx, r))
+					}
+				}()
+				res = ec._GraphQLTransaction_fromAccount(ctx, field, obj)
+				return res
+			}
+
+			out.Concurrently(i, func() graphql.Marshaler {
+				return innerFunc(ctx)
+
+			})
+		case "toAccount":
+			field := field
+
+			innerFunc := func(ctx context.Context) (res graphql.Marshaler) {
+				defer func() {
+					if r := recover(); r != nil {
+						ec.Error(ctx, ec.Recover(ctx, r))
+					}
+				}()
+				res = ec._GraphQLTransaction_toAccount(ctx, field, obj)
+				return res
+			}
+
+			out.Concurrently(i, func() graphql.Marshaler {
+				return innerFunc(ctx)
+
+			})
+		default:
+			panic("unknown field " + strconv.Quote(field.Name))
+		}
+	}
+	out.Dispatch()
+	if invalids > 0 {
+		return graphql.Null
+	}
+	return out
+}
+
+var nodeEdgeImplementors = []string{"NodeEdge"}
+
+func (ec *executionContext) _NodeEdge(ctx context.Context, sel ast.SelectionSet, obj *model.NodeEdge) graphql.Marshaler {
+	fields := graphql.CollectFields(ec.OperationContext, sel, nodeEdgeImplementors)
+	out := graphql.NewFieldSet(fields)
+	var invalids uint32
+	for i, field := range fields {
+		switch field.Name {
+		case "__typename":
+			out.Values[i] = graphql.MarshalString("NodeEdge")
+		case "cursor":
+
+			out.Values[i] = ec._NodeEdge_cursor(ctx, field, obj)
+
+			if out.Values[i] == graphql.Null {
+				invalids++
+			}
+		case "node":
+
+			out.Values[i] = ec._NodeEdge_node(ctx, field, obj)
 
 			if out.Values[i] == graphql.Null {
 				invalids++
@@ -8410,108 +11698,58 @@ func (ec *executionContext) _GraphQLNode(ctx context.Context, sel ast.SelectionS
 	return out
 }
 
-var graphQLTransactionImplementors = []string{"GraphQLTransaction"}
+var nodesResponseImplementors = []string{"NodesResponse"}
 
-func (ec *executionContext) _GraphQLTransaction(ctx context.Context, sel ast.SelectionSet, obj *model.GraphQLTransaction) graphql.Marshaler {
-	fields := graphql.CollectFields(ec.OperationContext, sel, graphQLTransactionImplementors)
+func (ec *executionContext) _NodesResponse(ctx context.Context, sel ast.SelectionSet, obj *model.NodesResponse) graphql.Marshaler {
+	fields := graphql.CollectFields(ec.OperationContext, sel, nodesResponseImplementors)
 	out := graphql.NewFieldSet(fields)
 	var invalids uint32
 	for i, field := range fields {
 		switch field.Name {
 		case "__typename":
-			out.Values[i] = graphql.MarshalString("GraphQLTransaction")
-		case "hash":
-
-			out.Values[i] = ec._GraphQLTransaction_hash(ctx, field, obj)
-
-			if out.Values[i] == graphql.Null {
-				invalids++
-			}
-		case "fromAddress":
-
-			out.Values[i] = ec._GraphQLTransaction_fromAddress(ctx, field, obj)
-
-			if out.Values[i] == graphql.Null {
-				invalids++
-			}
-		case "toAddress":
-
-			out.Values[i] = ec._GraphQLTransaction_toAddress(ctx, field, obj)
-
-			if out.Values[i] == graphql.Null {
-				invalids++
-			}
-		case "appPubKey":
-
-			out.Values[i] = ec._GraphQLTransaction_appPubKey(ctx, field, obj)
-
-			if out.Values[i] == graphql.Null {
-				invalids++
-			}
-		case "blockchains":
-
-			out.Values[i] = ec._GraphQLTransaction_blockchains(ctx, field, obj)
-
-		case "messageType":
-
-			out.Values[i] = ec._GraphQLTransaction_messageType(ctx, field, obj)
-
-			if out.Values[i] == graphql.Null {
-				invalids++
-			}
-		case "height":
+			out.Values[i] = graphql.MarshalString("NodesResponse")
+		case "nodes":
 
-			out.Values[i] = ec._GraphQLTransaction_height(ctx, field, obj)
+			out.Values[i] = ec._NodesResponse_nodes(ctx, field, obj)
 
-			if out.Values[i] == graphql.Null {
-				invalids++
-			}
-		case "index":
+		case "totalCount":
 
-			out.Values[i] = ec._GraphQLTransaction_index(ctx, field, obj)
+			out.Values[i] = ec._NodesResponse_totalCount(ctx, field, obj)
 
 			if out.Values[i] == graphql.Null {
 				invalids++
 			}
-		case "stdTx":
-
-			out.Values[i] = ec._GraphQLTransaction_stdTx(ctx, field, obj)
-
-		case "txResult":
-
-			out.Values[i] = ec._GraphQLTransaction_txResult(ctx, field, obj)
-
-		case "tx":
+		case "pageCount":
 
-			out.Values[i] = ec._GraphQLTransaction_tx(ctx, field, obj)
+			out.Values[i] = ec._NodesResponse_pageCount(ctx, field, obj)
 
 			if out.Values[i] == graphql.Null {
 				invalids++
 			}
-		case "entropy":
+		case "page":
 
-			out.Values[i] = ec._GraphQLTransaction_entropy(ctx, field, obj)
+			out.Values[i] = ec._NodesResponse_page(ctx, field, obj)
 
 			if out.Values[i] == graphql.Null {
 				invalids++
 			}
-		case "fee":
+		case "totalPages":
 
-			out.Values[i] = ec._GraphQLTransaction_fee(ctx, field, obj)
+			out.Values[i] = ec._NodesResponse_totalPages(ctx, field, obj)
 
 			if out.Values[i] == graphql.Null {
 				invalids++
 			}
-		case "feeDenomination":
+		case "edges":
 
-			out.Values[i] = ec._GraphQLTransaction_feeDenomination(ctx, field, obj)
+			out.Values[i] = ec._NodesResponse_edges(ctx, field, obj)
 
 			if out.Values[i] == graphql.Null {
 				invalids++
 			}
-		case "amount":
+		case "pageInfo":
 
-			out.Values[i] = ec._GraphQLTransaction_amount(ctx, field, obj)
+			out.Values[i] = ec._NodesResponse_pageInfo(ctx, field, obj)
 
 			if out.Values[i] == graphql.Null {
 				invalids++
@@ -8527,48 +11765,38 @@ func (ec *executionContext) _GraphQLTransaction(ctx context.Context, sel ast.Sel
 	return out
 }
 
-var nodesResponseImplementors = []string{"NodesResponse"}
+var pageInfoImplementors = []string{"PageInfo"}
 
-func (ec *executionContext) _NodesResponse(ctx context.Context, sel ast.SelectionSet, obj *model.NodesResponse) graphql.Marshaler {
-	fields := graphql.CollectFields(ec.OperationContext, sel, nodesResponseImplementors)
+func (ec *executionContext) _PageInfo(ctx context.Context, sel ast.SelectionSet, obj *model.PageInfo) graphql.Marshaler {
+	fields := graphql.CollectFields(ec.OperationContext, sel, pageInfoImplementors)
 	out := graphql.NewFieldSet(fields)
 	var invalids uint32
 	for i, field := range fields {
 		switch field.Name {
 		case "__typename":
-			out.Values[i] = graphql.MarshalString("NodesResponse")
-		case "nodes":
+			out.Values[i] = graphql.MarshalString("PageInfo")
+		case "hasNextPage":
 
-			out.Values[i] = ec._NodesResponse_nodes(ctx, field, obj)
-
-		case "totalCount":
-
-			out.Values[i] = ec._NodesResponse_totalCount(ctx, field, obj)
+			out.Values[i] = ec._PageInfo_hasNextPage(ctx, field, obj)
 
 			if out.Values[i] == graphql.Null {
 				invalids++
 			}
-		case "pageCount":
+		case "hasPreviousPage":
 
-			out.Values[i] = ec._NodesResponse_pageCount(ctx, field, obj)
+			out.Values[i] = ec._PageInfo_hasPreviousPage(ctx, field, obj)
 
 			if out.Values[i] == graphql.Null {
 				invalids++
 			}
-		case "page":
+		case "startCursor":
 
-			out.Values[i] = ec._NodesResponse_page(ctx, field, obj)
+			out.Values[i] = ec._PageInfo_startCursor(ctx, field, obj)
 
-			if out.Values[i] == graphql.Null {
-				invalids++
-			}
-		case "totalPages":
+		case "endCursor":
 
-			out.Values[i] = ec._NodesResponse_totalPages(ctx, field, obj)
+			out.Values[i] = ec._PageInfo_endCursor(ctx, field, obj)
 
-			if out.Values[i] == graphql.Null {
-				invalids++
-			}
 		default:
 			panic("unknown field " + strconv.Quote(field.Name))
 		}
@@ -8929,6 +12157,41 @@ func (ec *executionContext) _StdTx(ctx context.Context, sel ast.SelectionSet, ob
 	return out
 }
 
+var transactionEdgeImplementors = []string{"TransactionEdge"}
+
+func (ec *executionContext) _TransactionEdge(ctx context.Context, sel ast.SelectionSet, obj *model.TransactionEdge) graphql.Marshaler {
+	fields := graphql.CollectFields(ec.OperationContext, sel, transactionEdgeImplementors)
+	out := graphql.NewFieldSet(fields)
+	var invalids uint32
+	for i, field := range fields {
+		switch field.Name {
+		case "__typename":
+			out.Values[i] = graphql.MarshalString("TransactionEdge")
+		case "cursor":
+
+			out.Values[i] = ec._TransactionEdge_cursor(ctx, field, obj)
+
+			if out.Values[i] == graphql.Null {
+				invalids++
+			}
+		case "node":
+
+			out.Values[i] = ec._TransactionEdge_node(ctx, field, obj)
+
+			if out.Values[i] == graphql.Null {
+				invalids++
+			}
+		default:
+			panic("unknown field " + strconv.Quote(field.Name))
+		}
+	}
+	out.Dispatch()
+	if invalids > 0 {
+		return graphql.Null
+	}
+	return out
+}
+
 var transactionsResponseImplementors = []string{"TransactionsResponse"}
 
 func (ec *executionContext) _TransactionsResponse(ctx context.Context, sel ast.SelectionSet, obj *model.TransactionsResponse) graphql.Marshaler {
@@ -8968,6 +12231,20 @@ func (ec *executionContext) _TransactionsResponse(ctx context.Context, sel ast.S
 
 			out.Values[i] = ec._TransactionsResponse_totalPages(ctx, field, obj)
 
+			if out.Values[i] == graphql.Null {
+				invalids++
+			}
+		case "edges":
+
+			out.Values[i] = ec._TransactionsResponse_edges(ctx, field, obj)
+
+			if out.Values[i] == graphql.Null {
+				invalids++
+			}
+		case "pageInfo":
+
+			out.Values[i] = ec._TransactionsResponse_pageInfo(ctx, field, obj)
+
 			if out.Values[i] == graphql.Null {
 				invalids++
 			}
@@ -9428,29 +12705,201 @@ func (ec *executionContext) ___Type(ctx context.Context, sel ast.SelectionSet, o
 
 			out.Values[i] = ec.___Type_inputFields(ctx, field, obj)
 
-		case "ofType":
+		case "ofType":
+
+			out.Values[i] = ec.___Type_ofType(ctx, field, obj)
+
+		case "specifiedByURL":
+
+			out.Values[i] = ec.___Type_specifiedByURL(ctx, field, obj)
+
+		default:
+			panic("unknown field " + strconv.Quote(field.Name))
+		}
+	}
+	out.Dispatch()
+	if invalids > 0 {
+		return graphql.Null
+	}
+	return out
+}
+
+// endregion **************************** object.gotpl ****************************
+
+// region    ***************************** type.gotpl *****************************
+
+func (ec *executionContext) marshalNAccountEdge2ᚕᚖgithubᚗcomᚋpoktᚑfoundationᚋpocketᚑindexerᚑservicesᚋapiᚋgraphᚋmodelᚐAccountEdgeᚄ(ctx context.Context, sel ast.SelectionSet, v []*model.AccountEdge) graphql.Marshaler {
+	ret := make(graphql.Array, len(v))
+	var wg sync.WaitGroup
+	isLen1 := len(v) == 1
+	if !isLen1 {
+		wg.Add(len(v))
+	}
+	for i := range v {
+		i := i
+		fc := &graphql.FieldContext{
+			Index:  &i,
+			Result: &v[i],
+		}
+		ctx := graphql.WithFieldContext(ctx, fc)
+		f := func(i int) {
+			defer func() {
+				if r := recover(); r != nil {
+					ec.Error(ctx, ec.Recover(ctx, r))
+					ret = nil
+				}
+			}()
+			if !isLen1 {
+				defer wg.Done()
+			}
+			ret[i] = ec.marshalNAccountEdge2ᚖgithubᚗcomᚋpoktᚑfoundationᚋpocketᚑindexerᚑservicesᚋapiᚋgraphᚋmodelᚐAccountEdge(ctx, sel, v[i])
+		}
+		if isLen1 {
+			f(i)
+		} else {
+			go f(i)
+		}
+
+	}
+	wg.Wait()
+
+	for _, e := range ret {
+		if e == graphql.Null {
+			return graphql.Null
+		}
+	}
+
+	return ret
+}
+
+func (ec *executionContext) marshalNAccountEdge2ᚖgithubᚗcomᚋpoktᚑfoundationᚋpocketᚑindexerᚑservicesᚋapiᚋgraphᚋmodelᚐAccountEdge(ctx context.Context, sel ast.SelectionSet, v *model.AccountEdge) graphql.Marshaler {
+	if v == nil {
+		if !graphql.HasFieldError(ctx, graphql.GetFieldContext(ctx)) {
+			ec.Errorf(ctx, "the requested element is null which the schema does not allow")
+		}
+		return graphql.Null
+	}
+	return ec._AccountEdge(ctx, sel, v)
+}
+
+func (ec *executionContext) marshalNAppEdge2ᚕᚖgithubᚗcomᚋpoktᚑfoundationᚋpocketᚑindexerᚑservicesᚋapiᚋgraphᚋmodelᚐAppEdgeᚄ(ctx context.Context, sel ast.SelectionSet, v []*model.AppEdge) graphql.Marshaler {
+	ret := make(graphql.Array, len(v))
+	var wg sync.WaitGroup
+	isLen1 := len(v) == 1
+	if !isLen1 {
+		wg.Add(len(v))
+	}
+	for i := range v {
+		i := i
+		fc := &graphql.FieldContext{
+			Index:  &i,
+			Result: &v[i],
+		}
+		ctx := graphql.WithFieldContext(ctx, fc)
+		f := func(i int) {
+			defer func() {
+				if r := recover(); r != nil {
+					ec.Error(ctx, ec.Recover(ctx, r))
+					ret = nil
+				}
+			}()
+			if !isLen1 {
+				defer wg.Done()
+			}
+			ret[i] = ec.marshalNAppEdge2ᚖgithubᚗcomᚋpoktᚑfoundationᚋpocketᚑindexerᚑservicesᚋapiᚋgraphᚋmodelᚐAppEdge(ctx, sel, v[i])
+		}
+		if isLen1 {
+			f(i)
+		} else {
+			go f(i)
+		}
+
+	}
+	wg.Wait()
+
+	for _, e := range ret {
+		if e == graphql.Null {
+			return graphql.Null
+		}
+	}
+
+	return ret
+}
+
+func (ec *executionContext) marshalNAppEdge2ᚖgithubᚗcomᚋpoktᚑfoundationᚋpocketᚑindexerᚑservicesᚋapiᚋgraphᚋmodelᚐAppEdge(ctx context.Context, sel ast.SelectionSet, v *model.AppEdge) graphql.Marshaler {
+	if v == nil {
+		if !graphql.HasFieldError(ctx, graphql.GetFieldContext(ctx)) {
+			ec.Errorf(ctx, "the requested element is null which the schema does not allow")
+		}
+		return graphql.Null
+	}
+	return ec._AppEdge(ctx, sel, v)
+}
 
-			out.Values[i] = ec.___Type_ofType(ctx, field, obj)
+func (ec *executionContext) marshalNBlock2ᚖgithubᚗcomᚋpoktᚑfoundationᚋpocketᚑindexerᚑlibᚐBlock(ctx context.Context, sel ast.SelectionSet, v *indexer.Block) graphql.Marshaler {
+	if v == nil {
+		if !graphql.HasFieldError(ctx, graphql.GetFieldContext(ctx)) {
+			ec.Errorf(ctx, "the requested element is null which the schema does not allow")
+		}
+		return graphql.Null
+	}
+	return ec._Block(ctx, sel, v)
+}
 
-		case "specifiedByURL":
+func (ec *executionContext) marshalNBlockEdge2ᚕᚖgithubᚗcomᚋpoktᚑfoundationᚋpocketᚑindexerᚑservicesᚋapiᚋgraphᚋmodelᚐBlockEdgeᚄ(ctx context.Context, sel ast.SelectionSet, v []*model.BlockEdge) graphql.Marshaler {
+	ret := make(graphql.Array, len(v))
+	var wg sync.WaitGroup
+	isLen1 := len(v) == 1
+	if !isLen1 {
+		wg.Add(len(v))
+	}
+	for i := range v {
+		i := i
+		fc := &graphql.FieldContext{
+			Index:  &i,
+			Result: &v[i],
+		}
+		ctx := graphql.WithFieldContext(ctx, fc)
+		f := func(i int) {
+			defer func() {
+				if r := recover(); r != nil {
+					ec.Error(ctx, ec.Recover(ctx, r))
+					ret = nil
+				}
+			}()
+			if !isLen1 {
+				defer wg.Done()
+			}
+			ret[i] = ec.marshalNBlockEdge2ᚖgithubᚗcomᚋpoktᚑfoundationᚋpocketᚑindexerᚑservicesᚋapiᚋgraphᚋmodelᚐBlockEdge(ctx, sel, v[i])
+		}
+		if isLen1 {
+			f(i)
+		} else {
+			go f(i)
+		}
 
-			out.Values[i] = ec.___Type_specifiedByURL(ctx, field, obj)
+	}
+	wg.Wait()
 
-		default:
-			panic("unknown field " + strconv.Quote(field.Name))
+	for _, e := range ret {
+		if e == graphql.Null {
+			return graphql.Null
 		}
 	}
-	out.Dispatch()
-	if invalids > 0 {
+
+	return ret
+}
+
+func (ec *executionContext) marshalNBlockEdge2ᚖgithubᚗcomᚋpoktᚑfoundationᚋpocketᚑindexerᚑservicesᚋapiᚋgraphᚋmodelᚐBlockEdge(ctx context.Context, sel ast.SelectionSet, v *model.BlockEdge) graphql.Marshaler {
+	if v == nil {
+		if !graphql.HasFieldError(ctx, graphql.GetFieldContext(ctx)) {
+			ec.Errorf(ctx, "the requested element is null which the schema does not allow")
+		}
 		return graphql.Null
 	}
-	return out
+	return ec._BlockEdge(ctx, sel, v)
 }
 
-// endregion **************************** object.gotpl ****************************
-
-// region    ***************************** type.gotpl *****************************
-
 func (ec *executionContext) unmarshalNBoolean2bool(ctx context.Context, v interface{}) (bool, error) {
 	res, err := graphql.UnmarshalBoolean(v)
 	return res, graphql.ErrorOnPath(ctx, err)
@@ -9466,6 +12915,46 @@ func (ec *executionContext) marshalNBoolean2bool(ctx context.Context, sel ast.Se
 	return res
 }
 
+func (ec *executionContext) marshalNGraphQLAccount2ᚖgithubᚗcomᚋpoktᚑfoundationᚋpocketᚑindexerᚑservicesᚋapiᚋgraphᚋmodelᚐGraphQLAccount(ctx context.Context, sel ast.SelectionSet, v *model.GraphQLAccount) graphql.Marshaler {
+	if v == nil {
+		if !graphql.HasFieldError(ctx, graphql.GetFieldContext(ctx)) {
+			ec.Errorf(ctx, "the requested element is null which the schema does not allow")
+		}
+		return graphql.Null
+	}
+	return ec._GraphQLAccount(ctx, sel, v)
+}
+
+func (ec *executionContext) marshalNGraphQLApp2ᚖgithubᚗcomᚋpoktᚑfoundationᚋpocketᚑindexerᚑservicesᚋapiᚋgraphᚋmodelᚐGraphQLApp(ctx context.Context, sel ast.SelectionSet, v *model.GraphQLApp) graphql.Marshaler {
+	if v == nil {
+		if !graphql.HasFieldError(ctx, graphql.GetFieldContext(ctx)) {
+			ec.Errorf(ctx, "the requested element is null which the schema does not allow")
+		}
+		return graphql.Null
+	}
+	return ec._GraphQLApp(ctx, sel, v)
+}
+
+func (ec *executionContext) marshalNGraphQLNode2ᚖgithubᚗcomᚋpoktᚑfoundationᚋpocketᚑindexerᚑservicesᚋapiᚋgraphᚋmodelᚐGraphQLNode(ctx context.Context, sel ast.SelectionSet, v *model.GraphQLNode) graphql.Marshaler {
+	if v == nil {
+		if !graphql.HasFieldError(ctx, graphql.GetFieldContext(ctx)) {
+			ec.Errorf(ctx, "the requested element is null which the schema does not allow")
+		}
+		return graphql.Null
+	}
+	return ec._GraphQLNode(ctx, sel, v)
+}
+
+func (ec *executionContext) marshalNGraphQLTransaction2ᚖgithubᚗcomᚋpoktᚑfoundationᚋpocketᚑindexerᚑservicesᚋapiᚋgraphᚋmodelᚐGraphQLTransaction(ctx context.Context, sel ast.SelectionSet, v *model.GraphQLTransaction) graphql.Marshaler {
+	if v == nil {
+		if !graphql.HasFieldError(ctx, graphql.GetFieldContext(ctx)) {
+			ec.Errorf(ctx, "the requested element is null which the schema does not allow")
+		}
+		return graphql.Null
+	}
+	return ec._GraphQLTransaction(ctx, sel, v)
+}
+
 func (ec *executionContext) unmarshalNInt2int(ctx context.Context, v interface{}) (int, error) {
 	res, err := graphql.UnmarshalInt(v)
 	return res, graphql.ErrorOnPath(ctx, err)
@@ -9496,6 +12985,70 @@ func (ec *executionContext) marshalNInt2int64(ctx context.Context, sel ast.Selec
 	return res
 }
 
+func (ec *executionContext) marshalNNodeEdge2ᚕᚖgithubᚗcomᚋpoktᚑfoundationᚋpocketᚑindexerᚑservicesᚋapiᚋgraphᚋmodelᚐNodeEdgeᚄ(ctx context.Context, sel ast.SelectionSet, v []*model.NodeEdge) graphql.Marshaler {
+	ret := make(graphql.Array, len(v))
+	var wg sync.WaitGroup
+	isLen1 := len(v) == 1
+	if !isLen1 {
+		wg.Add(len(v))
+	}
+	for i := range v {
+		i := i
+		fc := &graphql.FieldContext{
+			Index:  &i,
+			Result: &v[i],
+		}
+		ctx := graphql.WithFieldContext(ctx, fc)
+		f := func(i int) {
+			defer func() {
+				if r := recover(); r != nil {
+					ec.Error(ctx, ec.Recover(ctx, r))
+					ret = nil
+				}
+			}()
+			if !isLen1 {
+				defer wg.Done()
+			}
+			ret[i] = ec.marshalNNodeEdge2ᚖgithubᚗcomᚋpoktᚑfoundationᚋpocketᚑindexerᚑservicesᚋapiᚋgraphᚋmodelᚐNodeEdge(ctx, sel, v[i])
+		}
+		if isLen1 {
+			f(i)
+		} else {
+			go f(i)
+		}
+
+	}
+	wg.Wait()
+
+	for _, e := range ret {
+		if e == graphql.Null {
+			return graphql.Null
+		}
+	}
+
+	return ret
+}
+
+func (ec *executionContext) marshalNNodeEdge2ᚖgithubᚗcomᚋpoktᚑfoundationᚋpocketᚑindexerᚑservicesᚋapiᚋgraphᚋmodelᚐNodeEdge(ctx context.Context, sel ast.SelectionSet, v *model.NodeEdge) graphql.Marshaler {
+	if v == nil {
+		if !graphql.HasFieldError(ctx, graphql.GetFieldContext(ctx)) {
+			ec.Errorf(ctx, "the requested element is null which the schema does not allow")
+		}
+		return graphql.Null
+	}
+	return ec._NodeEdge(ctx, sel, v)
+}
+
+func (ec *executionContext) marshalNPageInfo2ᚖgithubᚗcomᚋpoktᚑfoundationᚋpocketᚑindexerᚑservicesᚋapiᚋgraphᚋmodelᚐPageInfo(ctx context.Context, sel ast.SelectionSet, v *model.PageInfo) graphql.Marshaler {
+	if v == nil {
+		if !graphql.HasFieldError(ctx, graphql.GetFieldContext(ctx)) {
+			ec.Errorf(ctx, "the requested element is null which the schema does not allow")
+		}
+		return graphql.Null
+	}
+	return ec._PageInfo(ctx, sel, v)
+}
+
 func (ec *executionContext) unmarshalNString2string(ctx context.Context, v interface{}) (string, error) {
 	res, err := graphql.UnmarshalString(v)
 	return res, graphql.ErrorOnPath(ctx, err)
@@ -9526,6 +13079,60 @@ func (ec *executionContext) marshalNTime2timeᚐTime(ctx context.Context, sel as
 	return res
 }
 
+func (ec *executionContext) marshalNTransactionEdge2ᚕᚖgithubᚗcomᚋpoktᚑfoundationᚋpocketᚑindexerᚑservicesᚋapiᚋgraphᚋmodelᚐTransactionEdgeᚄ(ctx context.Context, sel ast.SelectionSet, v []*model.TransactionEdge) graphql.Marshaler {
+	ret := make(graphql.Array, len(v))
+	var wg sync.WaitGroup
+	isLen1 := len(v) == 1
+	if !isLen1 {
+		wg.Add(len(v))
+	}
+	for i := range v {
+		i := i
+		fc := &graphql.FieldContext{
+			Index:  &i,
+			Result: &v[i],
+		}
+		ctx := graphql.WithFieldContext(ctx, fc)
+		f := func(i int) {
+			defer func() {
+				if r := recover(); r != nil {
+					ec.Error(ctx, ec.Recover(ctx, r))
+					ret = nil
+				}
+			}()
+			if !isLen1 {
+				defer wg.Done()
+			}
+			ret[i] = ec.marshalNTransactionEdge2ᚖgithubᚗcomᚋpoktᚑfoundationᚋpocketᚑindexerᚑservicesᚋapiᚋgraphᚋmodelᚐTransactionEdge(ctx, sel, v[i])
+		}
+		if isLen1 {
+			f(i)
+		} else {
+			go f(i)
+		}
+
+	}
+	wg.Wait()
+
+	for _, e := range ret {
+		if e == graphql.Null {
+			return graphql.Null
+		}
+	}
+
+	return ret
+}
+
+func (ec *executionContext) marshalNTransactionEdge2ᚖgithubᚗcomᚋpoktᚑfoundationᚋpocketᚑindexerᚑservicesᚋapiᚋgraphᚋmodelᚐTransactionEdge(ctx context.Context, sel ast.SelectionSet, v *model.TransactionEdge) graphql.Marshaler {
+	if v == nil {
+		if !graphql.HasFieldError(ctx, graphql.GetFieldContext(ctx)) {
+			ec.Errorf(ctx, "the requested element is null which the schema does not allow")
+		}
+		return graphql.Null
+	}
+	return ec._TransactionEdge(ctx, sel, v)
+}
+
 func (ec *executionContext) marshalN__Directive2githubᚗcomᚋ99designsᚋgqlgenᚋgraphqlᚋintrospectionᚐDirective(ctx context.Context, sel ast.SelectionSet, v introspection.Directive) graphql.Marshaler {
 	return ec.___Directive(ctx, sel, &v)
 }
@@ -10231,6 +13838,62 @@ func (ec *executionContext) marshalOString2ᚖstring(ctx context.Context, sel as
 	return res
 }
 
+func (ec *executionContext) unmarshalOTime2ᚖtimeᚐTime(ctx context.Context, v interface{}) (*time.Time, error) {
+	if v == nil {
+		return nil, nil
+	}
+	res, err := graphql.UnmarshalTime(v)
+	return &res, graphql.ErrorOnPath(ctx, err)
+}
+
+func (ec *executionContext) marshalOTime2ᚖtimeᚐTime(ctx context.Context, sel ast.SelectionSet, v *time.Time) graphql.Marshaler {
+	if v == nil {
+		return graphql.Null
+	}
+	res := graphql.MarshalTime(*v)
+	return res
+}
+
+func (ec *executionContext) unmarshalOTransactionDirection2ᚖgithubᚗcomᚋpoktᚑfoundationᚋpocketᚑindexerᚑservicesᚋapiᚋgraphᚋmodelᚐTransactionDirection(ctx context.Context, v interface{}) (*model.TransactionDirection, error) {
+	if v == nil {
+		return nil, nil
+	}
+	var res = new(model.TransactionDirection)
+	err := res.UnmarshalGQL(v)
+	return res, graphql.ErrorOnPath(ctx, err)
+}
+
+func (ec *executionContext) marshalOTransactionDirection2ᚖgithubᚗcomᚋpoktᚑfoundationᚋpocketᚑindexerᚑservicesᚋapiᚋgraphᚋmodelᚐTransactionDirection(ctx context.Context, sel ast.SelectionSet, v *model.TransactionDirection) graphql.Marshaler {
+	if v == nil {
+		return graphql.Null
+	}
+	return v
+}
+
+func (ec *executionContext) unmarshalOTransactionFilter2ᚖgithubᚗcomᚋpoktᚑfoundationᚋpocketᚑindexerᚑservicesᚋapiᚋgraphᚋmodelᚐTransactionFilter(ctx context.Context, v interface{}) (*model.TransactionFilter, error) {
+	if v == nil {
+		return nil, nil
+	}
+	res, err := ec.unmarshalInputTransactionFilter(ctx, v)
+	return &res, graphql.ErrorOnPath(ctx, err)
+}
+
+func (ec *executionContext) unmarshalOTransactionResult2ᚖgithubᚗcomᚋpoktᚑfoundationᚋpocketᚑindexerᚑservicesᚋapiᚋgraphᚋmodelᚐTransactionResult(ctx context.Context, v interface{}) (*model.TransactionResult, error) {
+	if v == nil {
+		return nil, nil
+	}
+	var res = new(model.TransactionResult)
+	err := res.UnmarshalGQL(v)
+	return res, graphql.ErrorOnPath(ctx, err)
+}
+
+func (ec *executionContext) marshalOTransactionResult2ᚖgithubᚗcomᚋpoktᚑfoundationᚋpocketᚑindexerᚑservicesᚋapiᚋgraphᚋmodelᚐTransactionResult(ctx context.Context, sel ast.SelectionSet, v *model.TransactionResult) graphql.Marshaler {
+	if v == nil {
+		return graphql.Null
+	}
+	return v
+}
+
 func (ec *executionContext) marshalOTransactionsResponse2ᚖgithubᚗcomᚋpoktᚑfoundationᚋpocketᚑindexerᚑservicesᚋapiᚋgraphᚋmodelᚐTransactionsResponse(ctx context.Context, sel ast.SelectionSet, v *model.TransactionsResponse) graphql.Marshaler {
 	if v == nil {
 		return graphql.Null
@@ -0,0 +1,180 @@
+package graph
+
+import (
+	"context"
+	"strings"
+
+	"github.com/99designs/gqlgen/graphql"
+	"github.com/99designs/gqlgen/graphql/errcode"
+	"github.com/vektah/gqlparser/v2/ast"
+	"github.com/vektah/gqlparser/v2/gqlerror"
+)
+
+const (
+	errDepthLimit    = "DEPTH_LIMIT_EXCEEDED"
+	errPageSizeLimit = "PAGE_SIZE_LIMIT_EXCEEDED"
+)
+
+// pageSizeArguments are the arguments sizing the pages of the list fields
+var pageSizeArguments = []string{"perPage", "first", "last"}
+
+// QueryLimits struct handler for the limits checked before an operation is executed, the depth of its fields
+// and the size of the pages of its list fields, the introspection fields don't count for the depth
+type QueryLimits struct {
+	MaxDepth    int
+	MaxPageSize int
+}
+
+var _ interface {
+	graphql.OperationContextMutator
+	graphql.HandlerExtension
+} = QueryLimits{}
+
+// ExtensionName returns the name of the extension
+func (l QueryLimits) ExtensionName() string {
+	return "QueryLimits"
+}
+
+// Validate accepts every schema
+func (l QueryLimits) Validate(schema graphql.ExecutableSchema) error {
+	return nil
+}
+
+// MutateOperationContext rejects the operation when it exceeds the limits
+func (l QueryLimits) MutateOperationContext(ctx context.Context, rc *graphql.OperationContext) *gqlerror.Error {
+	operation := rc.Doc.Operations.ForName(rc.OperationName)
+	walk := newLimitsWalk(l, rc.Variables)
+
+	depth := walk.getSelectionDepth(operation.SelectionSet)
+	if depth > l.MaxDepth {
+		err := gqlerror.Errorf("operation has depth %d, which exceeds the limit of %d", depth, l.MaxDepth)
+		errcode.Set(err, errDepthLimit)
+
+		return err
+	}
+
+	return walk.checkPageSizes(operation.SelectionSet)
+}
+
+// limitsWalk walks the selections of an operation visiting each fragment once, the depth of a fragment
+// is kept for its next spreads and its page sizes are only checked on the first one, so fragments spreading
+// each other many times don't multiply the work done before the limits are checked
+type limitsWalk struct {
+	limits           QueryLimits
+	variables        map[string]interface{}
+	fragmentDepths   map[string]int
+	checkedFragments map[string]bool
+}
+
+func newLimitsWalk(limits QueryLimits, variables map[string]interface{}) *limitsWalk {
+	return &limitsWalk{
+		limits:           limits,
+		variables:        variables,
+		fragmentDepths:   make(map[string]int),
+		checkedFragments: make(map[string]bool),
+	}
+}
+
+func (w *limitsWalk) getSelectionDepth(selectionSet ast.SelectionSet) int {
+	depth := 0
+
+	for _, selection := range selectionSet {
+		selectionDepth := w.getDepth(selection)
+		if selectionDepth > depth {
+			depth = selectionDepth
+		}
+	}
+
+	return depth
+}
+
+// getDepth returns the depth of a selection, the fragments don't add a level to their fields
+func (w *limitsWalk) getDepth(selection ast.Selection) int {
+	switch s := selection.(type) {
+	case *ast.Field:
+		if strings.HasPrefix(s.Name, "__") {
+			return 0
+		}
+
+		return 1 + w.getSelectionDepth(s.SelectionSet)
+	case *ast.FragmentSpread:
+		if depth, ok := w.fragmentDepths[s.Name]; ok {
+			return depth
+		}
+
+		// A fragment spreading itself is rejected by the validation, it is only marked to never loop
+		w.fragmentDepths[s.Name] = 0
+		w.fragmentDepths[s.Name] = w.getSelectionDepth(getSelectionSet(s))
+
+		return w.fragmentDepths[s.Name]
+	default:
+		return w.getSelectionDepth(getSelectionSet(selection))
+	}
+}
+
+// checkPageSizes rejects the first field of the selection, or of the selections nested in it,
+// asking for a page bigger than the max page size
+func (w *limitsWalk) checkPageSizes(selectionSet ast.SelectionSet) *gqlerror.Error {
+	for _, selection := range selectionSet {
+		if spread, ok := selection.(*ast.FragmentSpread); ok {
+			if w.checkedFragments[spread.Name] {
+				continue
+			}
+
+			w.checkedFragments[spread.Name] = true
+		}
+
+		err := w.checkPageSizes(getSelectionSet(selection))
+		if err != nil {
+			return err
+		}
+
+		field, ok := selection.(*ast.Field)
+		if !ok {
+			continue
+		}
+
+		err = w.limits.checkPageSize(field, w.variables)
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (l QueryLimits) checkPageSize(field *ast.Field, variables map[string]interface{}) *gqlerror.Error {
+	arguments := field.ArgumentMap(variables)
+
+	for _, name := range pageSizeArguments {
+		size, err := graphql.UnmarshalInt(arguments[name])
+		if err != nil || size <= l.MaxPageSize {
+			continue
+		}
+
+		gqlErr := gqlerror.ErrorPosf(field.Position, "%s of %s is %d, which exceeds the limit of %d",
+			name, field.Alias, size, l.MaxPageSize)
+		errcode.Set(gqlErr, errPageSizeLimit)
+
+		return gqlErr
+	}
+
+	return nil
+}
+
+func getSelectionSet(selection ast.Selection) ast.SelectionSet {
+	switch s := selection.(type) {
+	case *ast.Field:
+		return s.SelectionSet
+	case *ast.InlineFragment:
+		return s.SelectionSet
+	case *ast.FragmentSpread:
+		if s.Definition == nil {
+			return nil
+		}
+
+		return s.Definition.SelectionSet
+	default:
+		return nil
+	}
+}
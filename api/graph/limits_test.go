@@ -0,0 +1,119 @@
+package graph
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/99designs/gqlgen/graphql"
+	"github.com/pokt-foundation/pocket-indexer-services/api/graph/generated"
+	"github.com/stretchr/testify/require"
+	"github.com/vektah/gqlparser/v2"
+	"github.com/vektah/gqlparser/v2/ast"
+)
+
+// nestedFragments returns a query whose fragments spread the next one twice, levels times,
+// so walking every spread would visit the last fragment 2^levels times
+func nestedFragments(levels int) string {
+	var query strings.Builder
+
+	query.WriteString("query { queryBlocks { blocks { ...F0 } } }\n")
+
+	for i := 0; i < levels; i++ {
+		fmt.Fprintf(&query, "fragment F%d on Block { height ...F%d ...F%d }\n", i, i+1, i+1)
+	}
+
+	fmt.Fprintf(&query, "fragment F%d on Block { hash }\n", levels)
+
+	return query.String()
+}
+
+func TestQueryLimits_MutateOperationContext(t *testing.T) {
+	schema := generated.NewExecutableSchema(generated.Config{}).Schema()
+	limits := QueryLimits{MaxDepth: 5, MaxPageSize: 100}
+
+	tests := []struct {
+		name      string
+		query     string
+		variables map[string]interface{}
+		code      string
+	}{
+		{name: "within the limits", query: `{ queryBlocks(perPage: 100) { blocks { height } } }`},
+		{
+			name:  "too deep",
+			query: `{ queryBlocks { blocks { transactions { transactions { block { height } } } } } }`,
+			code:  errDepthLimit,
+		},
+		{
+			name:  "introspection fields don't count",
+			query: `{ queryBlocks { blocks { transactions { transactions { __typename } } } } }`,
+		},
+		{
+			name:  "depth of the fields of a fragment",
+			query: `query { queryBlocks { ...Blocks } } fragment Blocks on BlocksResponse { blocks { transactions { transactions { block { height } } } } }`,
+			code:  errDepthLimit,
+		},
+		{
+			name:  "depth of the fields of an inline fragment",
+			query: `{ queryBlocks { ... on BlocksResponse { blocks { transactions { transactions { block { height } } } } } } }`,
+			code:  errDepthLimit,
+		},
+		{name: "page too big", query: `{ queryBlocks(first: 101) { blocks { height } } }`, code: errPageSizeLimit},
+		{
+			name:  "page of a nested list too big",
+			query: `{ queryBlocks(perPage: 10) { blocks { transactions(last: 500) { totalCount } } } }`,
+			code:  errPageSizeLimit,
+		},
+		{
+			name:      "page size of a variable",
+			query:     `query($perPage: Int) { queryBlocks(perPage: $perPage) { blocks { height } } }`,
+			variables: map[string]interface{}{"perPage": 1000},
+			code:      errPageSizeLimit,
+		},
+		{
+			name:  "page too big in a fragment spread many times",
+			query: `query { queryBlocks { blocks { ...T ...T } } } fragment T on Block { transactions(perPage: 500) { totalCount } }`,
+			code:  errPageSizeLimit,
+		},
+		{name: "fragments spread exponentially", query: nestedFragments(30)},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			c := require.New(t)
+
+			doc, errs := gqlparser.LoadQuery(schema, tt.query)
+			c.Empty(errs)
+
+			err := limits.MutateOperationContext(context.Background(), &graphql.OperationContext{
+				Doc:       doc,
+				Variables: tt.variables,
+			})
+			if tt.code == "" {
+				c.Nil(err)
+				return
+			}
+
+			c.NotNil(err)
+			c.Equal(tt.code, err.Extensions["code"])
+		})
+	}
+}
+
+func TestLimitsWalk_GetSelectionDepth(t *testing.T) {
+	c := require.New(t)
+
+	schema := generated.NewExecutableSchema(generated.Config{}).Schema()
+
+	doc, errs := gqlparser.LoadQuery(schema, nestedFragments(30))
+	c.Empty(errs)
+
+	walk := newLimitsWalk(QueryLimits{}, nil)
+
+	// queryBlocks, blocks and the fields of the fragments, which don't add a level
+	c.Equal(3, walk.getSelectionDepth(doc.Operations[0].SelectionSet))
+	c.Len(walk.fragmentDepths, 31)
+
+	c.Equal(0, walk.getDepth(&ast.Field{Name: "__schema"}))
+}
@@ -0,0 +1,99 @@
+package graph
+
+import (
+	"database/sql"
+	"sync"
+	"time"
+)
+
+// loader batches the keys loaded within wait of each other into a single read, up to maxBatch keys,
+// and caches what was read for the rest of the request
+type loader[K comparable, V any] struct {
+	fetch    func(keys []K) (map[K]V, error)
+	wait     time.Duration
+	maxBatch int
+
+	mu      sync.Mutex
+	batches map[K]*loaderBatch[K, V]
+	batch   *loaderBatch[K, V]
+}
+
+// loaderBatch is a read of keys, done is closed once values and err are set
+type loaderBatch[K comparable, V any] struct {
+	keys   []K
+	done   chan struct{}
+	values map[K]V
+	err    error
+}
+
+func newLoader[K comparable, V any](fetch func(keys []K) (map[K]V, error), wait time.Duration, maxBatch int) *loader[K, V] {
+	return &loader[K, V]{
+		fetch:    fetch,
+		wait:     wait,
+		maxBatch: maxBatch,
+		batches:  map[K]*loaderBatch[K, V]{},
+	}
+}
+
+// load returns the value of the key once the batch of the key was read,
+// sql.ErrNoRows when the read doesn't return it, the same as the reads of a single row
+func (l *loader[K, V]) load(key K) (V, error) {
+	batch := l.getBatch(key)
+
+	<-batch.done
+
+	value, ok := batch.values[key]
+	if batch.err == nil && !ok {
+		return value, sql.ErrNoRows
+	}
+
+	return value, batch.err
+}
+
+// getBatch returns the batch the key was or will be read in, adding it to the open batch when it wasn't loaded before
+func (l *loader[K, V]) getBatch(key K) *loaderBatch[K, V] {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	batch, ok := l.batches[key]
+	if ok {
+		return batch
+	}
+
+	if l.batch == nil {
+		l.batch = &loaderBatch[K, V]{done: make(chan struct{})}
+		go l.readAfterWait(l.batch)
+	}
+
+	batch = l.batch
+	batch.keys = append(batch.keys, key)
+	l.batches[key] = batch
+
+	if len(batch.keys) == l.maxBatch {
+		l.batch = nil
+		go l.read(batch)
+	}
+
+	return batch
+}
+
+func (l *loader[K, V]) readAfterWait(batch *loaderBatch[K, V]) {
+	time.Sleep(l.wait)
+
+	l.mu.Lock()
+	// A full batch was read already
+	if l.batch != batch {
+		l.mu.Unlock()
+		return
+	}
+
+	l.batch = nil
+	l.mu.Unlock()
+
+	l.read(batch)
+}
+
+func (l *loader[K, V]) read(batch *loaderBatch[K, V]) {
+	batch.values, batch.err = l.fetch(batch.keys)
+	close(batch.done)
+}
@@ -0,0 +1,171 @@
+package graph
+
+import (
+	"database/sql"
+	"errors"
+	"sort"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+// recordedFetch returns a fetch of the squares of the keys, leaving out the negative ones,
+// and the sorted keys of each read done
+func recordedFetch() (func(keys []int) (map[int]int, error), func() [][]int) {
+	var mu sync.Mutex
+	var reads [][]int
+
+	fetch := func(keys []int) (map[int]int, error) {
+		read := append([]int{}, keys...)
+		sort.Ints(read)
+
+		mu.Lock()
+		reads = append(reads, read)
+		mu.Unlock()
+
+		values := make(map[int]int, len(keys))
+		for _, key := range keys {
+			if key >= 0 {
+				values[key] = key * key
+			}
+		}
+
+		return values, nil
+	}
+
+	getReads := func() [][]int {
+		mu.Lock()
+		defer mu.Unlock()
+
+		sort.Slice(reads, func(i, j int) bool { return reads[i][0] < reads[j][0] })
+
+		return reads
+	}
+
+	return fetch, getReads
+}
+
+// loadConcurrently loads the keys at once, as the resolvers of the items of a list do
+func loadConcurrently(l *loader[int, int], keys []int) ([]int, []error) {
+	values := make([]int, len(keys))
+	errs := make([]error, len(keys))
+
+	var wg sync.WaitGroup
+
+	for i, key := range keys {
+		wg.Add(1)
+
+		go func(i, key int) {
+			defer wg.Done()
+			values[i], errs[i] = l.load(key)
+		}(i, key)
+	}
+
+	wg.Wait()
+
+	return values, errs
+}
+
+func TestLoader_Load(t *testing.T) {
+	tests := []struct {
+		name     string
+		maxBatch int
+		keys     []int
+		reads    [][]int
+	}{
+		{name: "single key", maxBatch: 10, keys: []int{3}, reads: [][]int{{3}}},
+		{name: "keys batched", maxBatch: 10, keys: []int{1, 2, 3, 4}, reads: [][]int{{1, 2, 3, 4}}},
+		{name: "repeated keys read once", maxBatch: 10, keys: []int{1, 2, 1, 2}, reads: [][]int{{1, 2}}},
+		{name: "batch split by the max", maxBatch: 2, keys: []int{1, 2, 3, 4, 5}, reads: [][]int{{1, 2}, {3, 4}, {5}}},
+		{name: "full batch", maxBatch: 3, keys: []int{1, 2, 3}, reads: [][]int{{1, 2, 3}}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			c := require.New(t)
+
+			fetch, getReads := recordedFetch()
+			l := newLoader(fetch, 50*time.Millisecond, tt.maxBatch)
+
+			// The keys are added in order so the splits of the batches are known
+			for _, key := range tt.keys {
+				l.getBatch(key)
+			}
+
+			values, errs := loadConcurrently(l, tt.keys)
+
+			for i, key := range tt.keys {
+				c.NoError(errs[i])
+				c.Equal(key*key, values[i])
+			}
+
+			c.Equal(tt.reads, getReads())
+		})
+	}
+}
+
+func TestLoader_LoadConcurrently(t *testing.T) {
+	c := require.New(t)
+
+	fetch, getReads := recordedFetch()
+	l := newLoader(fetch, 50*time.Millisecond, 100)
+
+	keys := make([]int, 0, 250)
+	for key := 0; key < 250; key++ {
+		keys = append(keys, key)
+	}
+
+	values, errs := loadConcurrently(l, keys)
+
+	for i, key := range keys {
+		c.NoError(errs[i])
+		c.Equal(key*key, values[i])
+	}
+
+	// A slow start of the goroutines can split the keys in more batches, never over the max
+	read := 0
+
+	for _, keys := range getReads() {
+		c.LessOrEqual(len(keys), 100)
+		read += len(keys)
+	}
+
+	c.Equal(250, read)
+}
+
+func TestLoader_LoadCached(t *testing.T) {
+	c := require.New(t)
+
+	fetch, getReads := recordedFetch()
+	l := newLoader(fetch, time.Millisecond, 10)
+
+	value, err := l.load(2)
+	c.NoError(err)
+	c.Equal(4, value)
+
+	value, err = l.load(2)
+	c.NoError(err)
+	c.Equal(4, value)
+
+	c.Equal([][]int{{2}}, getReads())
+}
+
+func TestLoader_LoadErrors(t *testing.T) {
+	c := require.New(t)
+
+	fetch, _ := recordedFetch()
+	l := newLoader(fetch, time.Millisecond, 10)
+
+	_, err := l.load(-1)
+	c.Equal(sql.ErrNoRows, err)
+
+	failing := newLoader(func(keys []int) (map[int]int, error) {
+		return nil, errors.New("dummy error")
+	}, time.Millisecond, 10)
+
+	_, errs := loadConcurrently(failing, []int{1, 2})
+	c.EqualError(errs[0], "dummy error")
+	c.EqualError(errs[1], "dummy error")
+}
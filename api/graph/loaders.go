@@ -0,0 +1,79 @@
+package graph
+
+import (
+	"context"
+	"net/http"
+	"time"
+
+	indexerlib "github.com/pokt-foundation/pocket-indexer-lib"
+	"github.com/pokt-foundation/pocket-indexer-services/storage"
+)
+
+const (
+	// loaderWait is how long a loader waits for the keys of the sibling fields before reading them
+	loaderWait = 2 * time.Millisecond
+	// loaderMaxBatch is the most keys read at once, a page of the default size is read in a single batch
+	loaderMaxBatch = 1000
+)
+
+type loadersKey struct{}
+
+// loaders are the batch reads of the nested fields of a request, so the fields of the rows of a page
+// are read together instead of once per row
+type loaders struct {
+	blocksByHeight     *loader[int, *indexerlib.Block]
+	transactionsByHash *loader[string, *indexerlib.Transaction]
+	accountsAtHeight   *loader[storage.AccountKey, *indexerlib.Account]
+}
+
+func newLoaders(reader reader) *loaders {
+	return &loaders{
+		blocksByHeight: newLoader(func(heights []int) (map[int]*indexerlib.Block, error) {
+			blocks, err := reader.ReadBlocksByHeights(heights)
+			if err != nil {
+				return nil, err
+			}
+
+			blocksByHeight := make(map[int]*indexerlib.Block, len(blocks))
+			for _, block := range blocks {
+				blocksByHeight[block.Height] = block
+			}
+
+			return blocksByHeight, nil
+		}, loaderWait, loaderMaxBatch),
+		transactionsByHash: newLoader(func(hashes []string) (map[string]*indexerlib.Transaction, error) {
+			transactions, err := reader.ReadTransactionsByHashes(hashes)
+			if err != nil {
+				return nil, err
+			}
+
+			transactionsByHash := make(map[string]*indexerlib.Transaction, len(transactions))
+			for _, transaction := range transactions {
+				transactionsByHash[transaction.Hash] = transaction
+			}
+
+			return transactionsByHash, nil
+		}, loaderWait, loaderMaxBatch),
+		accountsAtHeight: newLoader(reader.ReadAccountsAtHeights, loaderWait, loaderMaxBatch),
+	}
+}
+
+// WithLoaders returns the handler with new loaders in the context of each request
+func (r *Resolver) WithLoaders(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+		ctx := context.WithValue(req.Context(), loadersKey{}, newLoaders(r.Reader))
+
+		next.ServeHTTP(w, req.WithContext(ctx))
+	})
+}
+
+// getLoaders returns the loaders of the request, new ones that don't batch across fields
+// when the handler doesn't set them
+func (r *Resolver) getLoaders(ctx context.Context) *loaders {
+	requestLoaders, ok := ctx.Value(loadersKey{}).(*loaders)
+	if !ok {
+		return newLoaders(r.Reader)
+	}
+
+	return requestLoaders
+}
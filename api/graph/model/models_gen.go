@@ -3,16 +3,33 @@
 package model
 
 import (
+	"fmt"
+	"io"
+	"strconv"
+	"time"
+
 	"github.com/pokt-foundation/pocket-go/provider"
 	indexer "github.com/pokt-foundation/pocket-indexer-lib"
 )
 
+type AccountEdge struct {
+	Cursor string          `json:"cursor"`
+	Node   *GraphQLAccount `json:"node"`
+}
+
 type AccountsResponse struct {
 	Accounts   []*GraphQLAccount `json:"accounts"`
 	TotalCount int               `json:"totalCount"`
 	PageCount  int               `json:"pageCount"`
 	Page       int               `json:"page"`
 	TotalPages int               `json:"totalPages"`
+	Edges      []*AccountEdge    `json:"edges"`
+	PageInfo   *PageInfo         `json:"pageInfo"`
+}
+
+type AppEdge struct {
+	Cursor string      `json:"cursor"`
+	Node   *GraphQLApp `json:"node"`
 }
 
 type AppsResponse struct {
@@ -21,6 +38,13 @@ type AppsResponse struct {
 	PageCount  int           `json:"pageCount"`
 	Page       int           `json:"page"`
 	TotalPages int           `json:"totalPages"`
+	Edges      []*AppEdge    `json:"edges"`
+	PageInfo   *PageInfo     `json:"pageInfo"`
+}
+
+type BlockEdge struct {
+	Cursor string         `json:"cursor"`
+	Node   *indexer.Block `json:"node"`
 }
 
 type BlocksResponse struct {
@@ -29,6 +53,8 @@ type BlocksResponse struct {
 	PageCount  int              `json:"pageCount"`
 	Page       int              `json:"page"`
 	TotalPages int              `json:"totalPages"`
+	Edges      []*BlockEdge     `json:"edges"`
+	PageInfo   *PageInfo        `json:"pageInfo"`
 }
 
 type GraphQLAccount struct {
@@ -40,20 +66,24 @@ type GraphQLAccount struct {
 }
 
 type GraphQLApp struct {
-	Address      string `json:"address"`
-	Height       int    `json:"height"`
-	Jailed       bool   `json:"jailed"`
-	PublicKey    string `json:"publicKey"`
-	StakedTokens string `json:"stakedTokens"`
+	Address      string                `json:"address"`
+	Height       int                   `json:"height"`
+	Jailed       bool                  `json:"jailed"`
+	PublicKey    string                `json:"publicKey"`
+	StakedTokens string                `json:"stakedTokens"`
+	Account      *GraphQLAccount       `json:"account"`
+	Transactions *TransactionsResponse `json:"transactions"`
 }
 
 type GraphQLNode struct {
-	Address    string `json:"address"`
-	Height     int    `json:"height"`
-	Jailed     bool   `json:"jailed"`
-	PublicKey  string `json:"publicKey"`
-	ServiceURL string `json:"serviceURL"`
-	Tokens     string `json:"tokens"`
+	Address      string                `json:"address"`
+	Height       int                   `json:"height"`
+	Jailed       bool                  `json:"jailed"`
+	PublicKey    string                `json:"publicKey"`
+	ServiceURL   string                `json:"serviceURL"`
+	Tokens       string                `json:"tokens"`
+	Account      *GraphQLAccount       `json:"account"`
+	Transactions *TransactionsResponse `json:"transactions"`
 }
 
 type GraphQLTransaction struct {
@@ -72,6 +102,14 @@ type GraphQLTransaction struct {
 	Fee             int                `json:"fee"`
 	FeeDenomination string             `json:"feeDenomination"`
 	Amount          string             `json:"amount"`
+	Block           *indexer.Block     `json:"block"`
+	FromAccount     *GraphQLAccount    `json:"fromAccount"`
+	ToAccount       *GraphQLAccount    `json:"toAccount"`
+}
+
+type NodeEdge struct {
+	Cursor string       `json:"cursor"`
+	Node   *GraphQLNode `json:"node"`
 }
 
 type NodesResponse struct {
@@ -80,6 +118,35 @@ type NodesResponse struct {
 	PageCount  int            `json:"pageCount"`
 	Page       int            `json:"page"`
 	TotalPages int            `json:"totalPages"`
+	Edges      []*NodeEdge    `json:"edges"`
+	PageInfo   *PageInfo      `json:"pageInfo"`
+}
+
+type PageInfo struct {
+	HasNextPage     bool    `json:"hasNextPage"`
+	HasPreviousPage bool    `json:"hasPreviousPage"`
+	StartCursor     *string `json:"startCursor"`
+	EndCursor       *string `json:"endCursor"`
+}
+
+type TransactionEdge struct {
+	Cursor string              `json:"cursor"`
+	Node   *GraphQLTransaction `json:"node"`
+}
+
+type TransactionFilter struct {
+	MessageType *string               `json:"messageType"`
+	Blockchains []string              `json:"blockchains"`
+	FromHeight  *int                  `json:"fromHeight"`
+	ToHeight    *int                  `json:"toHeight"`
+	FromTime    *time.Time            `json:"fromTime"`
+	ToTime      *time.Time            `json:"toTime"`
+	Direction   *TransactionDirection `json:"direction"`
+	Result      *TransactionResult    `json:"result"`
+	MinFee      *int                  `json:"minFee"`
+	MaxFee      *int                  `json:"maxFee"`
+	MinAmount   *string               `json:"minAmount"`
+	MaxAmount   *string               `json:"maxAmount"`
 }
 
 type TransactionsResponse struct {
@@ -88,4 +155,88 @@ type TransactionsResponse struct {
 	PageCount    int                   `json:"pageCount"`
 	Page         int                   `json:"page"`
 	TotalPages   int                   `json:"totalPages"`
+	Edges        []*TransactionEdge    `json:"edges"`
+	PageInfo     *PageInfo             `json:"pageInfo"`
+}
+
+type TransactionDirection string
+
+const (
+	TransactionDirectionSent     TransactionDirection = "sent"
+	TransactionDirectionReceived TransactionDirection = "received"
+)
+
+var AllTransactionDirection = []TransactionDirection{
+	TransactionDirectionSent,
+	TransactionDirectionReceived,
+}
+
+func (e TransactionDirection) IsValid() bool {
+	switch e {
+	case TransactionDirectionSent, TransactionDirectionReceived:
+		return true
+	}
+	return false
+}
+
+func (e TransactionDirection) String() string {
+	return string(e)
+}
+
+func (e *TransactionDirection) UnmarshalGQL(v interface{}) error {
+	str, ok := v.(string)
+	if !ok {
+		return fmt.Errorf("enums must be strings")
+	}
+
+	*e = TransactionDirection(str)
+	if !e.IsValid() {
+		return fmt.Errorf("%s is not a valid TransactionDirection", str)
+	}
+	return nil
+}
+
+func (e TransactionDirection) MarshalGQL(w io.Writer) {
+	fmt.Fprint(w, strconv.Quote(e.String()))
+}
+
+type TransactionResult string
+
+const (
+	TransactionResultSuccess TransactionResult = "success"
+	TransactionResultFailure TransactionResult = "failure"
+)
+
+var AllTransactionResult = []TransactionResult{
+	TransactionResultSuccess,
+	TransactionResultFailure,
+}
+
+func (e TransactionResult) IsValid() bool {
+	switch e {
+	case TransactionResultSuccess, TransactionResultFailure:
+		return true
+	}
+	return false
+}
+
+func (e TransactionResult) String() string {
+	return string(e)
+}
+
+func (e *TransactionResult) UnmarshalGQL(v interface{}) error {
+	str, ok := v.(string)
+	if !ok {
+		return fmt.Errorf("enums must be strings")
+	}
+
+	*e = TransactionResult(str)
+	if !e.IsValid() {
+		return fmt.Errorf("%s is not a valid TransactionResult", str)
+	}
+	return nil
+}
+
+func (e TransactionResult) MarshalGQL(w io.Writer) {
+	fmt.Fprint(w, strconv.Quote(e.String()))
 }
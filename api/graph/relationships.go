@@ -0,0 +1,39 @@
+package graph
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+
+	indexer "github.com/pokt-foundation/pocket-indexer-lib"
+	"github.com/pokt-foundation/pocket-indexer-services/api/graph/model"
+	"github.com/pokt-foundation/pocket-indexer-services/storage"
+)
+
+// readBlock returns the block at the height, nil when it is not saved
+func (r *Resolver) readBlock(ctx context.Context, height int) (*indexer.Block, error) {
+	block, err := r.getLoaders(ctx).blocksByHeight.load(height)
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, nil
+	}
+
+	return block, err
+}
+
+// readAccountAtHeight returns the account of the address as of the height, nil when there is no address
+// or no account of it was saved by then
+func (r *Resolver) readAccountAtHeight(ctx context.Context, address string, height int) (*model.GraphQLAccount, error) {
+	if address == "" {
+		return nil, nil
+	}
+
+	account, err := r.getLoaders(ctx).accountsAtHeight.load(storage.AccountKey{Address: address, Height: height})
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	return convertIndexerAccountToGraphQLAccount(account), nil
+}
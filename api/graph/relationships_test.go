@@ -0,0 +1,106 @@
+package graph
+
+import (
+	"context"
+	"errors"
+	"math/big"
+	"testing"
+
+	indexerlib "github.com/pokt-foundation/pocket-indexer-lib"
+	"github.com/pokt-foundation/pocket-indexer-services/storage"
+	"github.com/stretchr/testify/require"
+)
+
+// fakeReader reads the blocks, accounts and transactions set and counts its transaction counts,
+// the methods not overridden panic through the nil reader embedded
+type fakeReader struct {
+	reader
+
+	blocks       map[int]*indexerlib.Block
+	accounts     map[storage.AccountKey]*indexerlib.Account
+	transactions []*indexerlib.Transaction
+	counts       int
+	err          error
+}
+
+func (r *fakeReader) ReadBlocksByHeights(heights []int) ([]*indexerlib.Block, error) {
+	var blocks []*indexerlib.Block
+
+	for _, height := range heights {
+		if block, ok := r.blocks[height]; ok {
+			blocks = append(blocks, block)
+		}
+	}
+
+	return blocks, r.err
+}
+
+func (r *fakeReader) ReadAccountsAtHeights(keys []storage.AccountKey) (map[storage.AccountKey]*indexerlib.Account, error) {
+	accounts := make(map[storage.AccountKey]*indexerlib.Account)
+
+	for _, key := range keys {
+		if account, ok := r.accounts[key]; ok {
+			accounts[key] = account
+		}
+	}
+
+	return accounts, r.err
+}
+
+func TestResolver_ReadBlock(t *testing.T) {
+	c := require.New(t)
+
+	fakeReader := &fakeReader{blocks: map[int]*indexerlib.Block{10: {Height: 10, Hash: "hash"}}}
+	r := &Resolver{Reader: fakeReader}
+
+	block, err := r.readBlock(context.Background(), 10)
+	c.NoError(err)
+	c.Equal("hash", block.Hash)
+
+	// A block not saved is mapped to null instead of an error
+	block, err = r.readBlock(context.Background(), 11)
+	c.NoError(err)
+	c.Nil(block)
+
+	fakeReader.err = errDummy
+
+	_, err = r.readBlock(context.Background(), 10)
+	c.ErrorIs(err, errDummy)
+}
+
+func TestResolver_ReadAccountAtHeight(t *testing.T) {
+	c := require.New(t)
+
+	fakeReader := &fakeReader{
+		accounts: map[storage.AccountKey]*indexerlib.Account{
+			{Address: "sender", Height: 10}: {
+				Address:     "sender",
+				Height:      8,
+				AccountType: indexerlib.AccountTypeNode,
+				Balance:     big.NewInt(100),
+			},
+		},
+	}
+	r := &Resolver{Reader: fakeReader}
+
+	account, err := r.readAccountAtHeight(context.Background(), "sender", 10)
+	c.NoError(err)
+	c.Equal(8, account.Height)
+	c.Equal("100", account.Balance)
+
+	// No address and an account not saved by the height are both mapped to null
+	account, err = r.readAccountAtHeight(context.Background(), "", 10)
+	c.NoError(err)
+	c.Nil(account)
+
+	account, err = r.readAccountAtHeight(context.Background(), "sender", 5)
+	c.NoError(err)
+	c.Nil(account)
+
+	fakeReader.err = errDummy
+
+	_, err = r.readAccountAtHeight(context.Background(), "sender", 10)
+	c.ErrorIs(err, errDummy)
+}
+
+var errDummy = errors.New("dummy error")
@@ -6,6 +6,7 @@ import (
 	indexerlib "github.com/pokt-foundation/pocket-indexer-lib"
 	postgresdriver "github.com/pokt-foundation/pocket-indexer-lib/postgres-driver"
 	"github.com/pokt-foundation/pocket-indexer-services/api/graph/model"
+	"github.com/pokt-foundation/pocket-indexer-services/storage"
 )
 
 // This file will not be regenerated automatically.
@@ -20,18 +21,14 @@ var (
 
 // reader interface of needed functions for the db reader
 type reader interface {
-	ReadTransactions(options *postgresdriver.ReadTransactionsOptions) ([]*indexerlib.Transaction, error)
-	GetTransactionsQuantity() (int64, error)
-	ReadTransactionsByAddress(address string, options *postgresdriver.ReadTransactionsByAddressOptions) ([]*indexerlib.Transaction, error)
-	GetTransactionsQuantityByAddress(address string) (int64, error)
-	ReadTransactionsByHeight(height int, options *postgresdriver.ReadTransactionsByHeightOptions) ([]*indexerlib.Transaction, error)
-	GetTransactionsQuantityByHeight(height int) (int64, error)
-	ReadTransactionByHash(hash string) (*indexerlib.Transaction, error)
+	ReadTransactionsByHashes(hashes []string) ([]*indexerlib.Transaction, error)
 	ReadBlocks(options *postgresdriver.ReadBlocksOptions) ([]*indexerlib.Block, error)
 	GetBlocksQuantity() (int64, error)
 	ReadBlockByHash(hash string) (*indexerlib.Block, error)
 	ReadBlockByHeight(height int) (*indexerlib.Block, error)
+	ReadBlocksByHeights(heights []int) ([]*indexerlib.Block, error)
 	ReadAccountByAddress(address string, options *postgresdriver.ReadAccountByAddressOptions) (*indexerlib.Account, error)
+	ReadAccountsAtHeights(keys []storage.AccountKey) (map[storage.AccountKey]*indexerlib.Account, error)
 	ReadAccounts(options *postgresdriver.ReadAccountsOptions) ([]*indexerlib.Account, error)
 	GetAccountsQuantity(options *postgresdriver.GetAccountsQuantityOptions) (int64, error)
 	ReadNodeByAddress(address string, options *postgresdriver.ReadNodeByAddressOptions) (*indexerlib.Node, error)
@@ -40,6 +37,12 @@ type reader interface {
 	ReadAppByAddress(address string, options *postgresdriver.ReadAppByAddressOptions) (*indexerlib.App, error)
 	ReadApps(options *postgresdriver.ReadAppsOptions) ([]*indexerlib.App, error)
 	GetAppsQuantity(options *postgresdriver.GetAppsQuantityOptions) (int64, error)
+	ReadBlocksPage(options *storage.PageOptions) ([]*indexerlib.Block, bool, error)
+	ReadTransactionsPage(filter *storage.TransactionFilter, options *storage.PageOptions) ([]*indexerlib.Transaction, bool, error)
+	CountTransactions(filter *storage.TransactionFilter) (int64, error)
+	ReadAccountsPage(height int, options *storage.PageOptions) ([]*indexerlib.Account, bool, error)
+	ReadNodesPage(height int, options *storage.PageOptions) ([]*indexerlib.Node, bool, error)
+	ReadAppsPage(height int, options *storage.PageOptions) ([]*indexerlib.App, bool, error)
 }
 
 func getTotalPages(quantity, perPage int) int {
@@ -145,6 +148,17 @@ func convertMultipleIndexeraAppToGraphQLApp(apps []*indexerlib.App) []*model.Gra
 }
 
 // Resolver struct handler for dependency injection to GraphQL operations
+// MaxPageSize caps the default page size when it is set
 type Resolver struct {
-	Reader reader
+	Reader      reader
+	MaxPageSize int
+}
+
+// getDefaultPerPage returns the size of the pages not sized by the query
+func (r *Resolver) getDefaultPerPage() int {
+	if r.MaxPageSize > 0 && r.MaxPageSize < defaultPerPage {
+		return r.MaxPageSize
+	}
+
+	return defaultPerPage
 }
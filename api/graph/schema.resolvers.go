@@ -10,8 +10,63 @@ import (
 	postgresdriver "github.com/pokt-foundation/pocket-indexer-lib/postgres-driver"
 	"github.com/pokt-foundation/pocket-indexer-services/api/graph/generated"
 	"github.com/pokt-foundation/pocket-indexer-services/api/graph/model"
+	"github.com/pokt-foundation/pocket-indexer-services/storage"
 )
 
+func (r *blockResolver) Transactions(ctx context.Context, obj *indexer.Block, page *int, perPage *int, filter *model.TransactionFilter, first *int, after *string, last *int, before *string) (*model.TransactionsResponse, error) {
+	transactionFilter, err := getTransactionFilter(filter, "")
+	if err != nil {
+		return nil, err
+	}
+
+	transactionFilter.Height = obj.Height
+	args := newConnectionArgs(ctx, page, perPage, first, after, last, before)
+
+	return r.readTransactions(args, postgresdriver.AscendantOrder, transactionFilter)
+}
+
+func (r *graphQLAppResolver) Account(ctx context.Context, obj *model.GraphQLApp) (*model.GraphQLAccount, error) {
+	return r.readAccountAtHeight(ctx, obj.Address, obj.Height)
+}
+
+func (r *graphQLAppResolver) Transactions(ctx context.Context, obj *model.GraphQLApp, page *int, perPage *int, order *postgresdriver.Order, filter *model.TransactionFilter, first *int, after *string, last *int, before *string) (*model.TransactionsResponse, error) {
+	transactionFilter, err := getTransactionFilter(filter, obj.Address)
+	if err != nil {
+		return nil, err
+	}
+
+	args := newConnectionArgs(ctx, page, perPage, first, after, last, before)
+
+	return r.readTransactions(args, getOrder(order, postgresdriver.DescendantOrder), transactionFilter)
+}
+
+func (r *graphQLNodeResolver) Account(ctx context.Context, obj *model.GraphQLNode) (*model.GraphQLAccount, error) {
+	return r.readAccountAtHeight(ctx, obj.Address, obj.Height)
+}
+
+func (r *graphQLNodeResolver) Transactions(ctx context.Context, obj *model.GraphQLNode, page *int, perPage *int, order *postgresdriver.Order, filter *model.TransactionFilter, first *int, after *string, last *int, before *string) (*model.TransactionsResponse, error) {
+	transactionFilter, err := getTransactionFilter(filter, obj.Address)
+	if err != nil {
+		return nil, err
+	}
+
+	args := newConnectionArgs(ctx, page, perPage, first, after, last, before)
+
+	return r.readTransactions(args, getOrder(order, postgresdriver.DescendantOrder), transactionFilter)
+}
+
+func (r *graphQLTransactionResolver) Block(ctx context.Context, obj *model.GraphQLTransaction) (*indexer.Block, error) {
+	return r.readBlock(ctx, obj.Height)
+}
+
+func (r *graphQLTransactionResolver) FromAccount(ctx context.Context, obj *model.GraphQLTransaction) (*model.GraphQLAccount, error) {
+	return r.readAccountAtHeight(ctx, obj.FromAddress, obj.Height)
+}
+
+func (r *graphQLTransactionResolver) ToAccount(ctx context.Context, obj *model.GraphQLTransaction) (*model.GraphQLAccount, error) {
+	return r.readAccountAtHeight(ctx, obj.ToAddress, obj.Height)
+}
+
 func (r *queryResolver) QueryBlockByHash(ctx context.Context, hash string) (*indexer.Block, error) {
 	return r.Reader.ReadBlockByHash(hash)
 }
@@ -20,10 +75,15 @@ func (r *queryResolver) QueryBlockByHeight(ctx context.Context, height int) (*in
 	return r.Reader.ReadBlockByHeight(height)
 }
 
-func (r *queryResolver) QueryBlocks(ctx context.Context, page *int, perPage *int, order *postgresdriver.Order) (*model.BlocksResponse, error) {
+func (r *queryResolver) QueryBlocks(ctx context.Context, page *int, perPage *int, order *postgresdriver.Order, first *int, after *string, last *int, before *string) (*model.BlocksResponse, error) {
+	args := newConnectionArgs(ctx, page, perPage, first, after, last, before)
+	if args.isCursorPage() {
+		return r.readBlocksCursorPage(args, getOrder(order, defaultOrder))
+	}
+
 	options := &postgresdriver.ReadBlocksOptions{
 		Page:    defaultPage,
-		PerPage: defaultPerPage,
+		PerPage: r.getDefaultPerPage(),
 		Order:   defaultOrder,
 	}
 
@@ -47,17 +107,22 @@ func (r *queryResolver) QueryBlocks(ctx context.Context, page *int, perPage *int
 		return nil, err
 	}
 
+	edges, cursors := getBlockEdges(blocks)
+	totalPages := getTotalPages(int(quantity), options.PerPage)
+
 	return &model.BlocksResponse{
 		Blocks:     blocks,
 		Page:       options.Page,
 		TotalCount: int(quantity),
 		PageCount:  len(blocks),
-		TotalPages: getTotalPages(int(quantity), options.PerPage),
+		TotalPages: totalPages,
+		Edges:      edges,
+		PageInfo:   getOffsetPageInfo(options.Page, options.Page < totalPages, cursors),
 	}, nil
 }
 
 func (r *queryResolver) QueryTransactionByHash(ctx context.Context, hash string) (*model.GraphQLTransaction, error) {
-	transaction, err := r.Reader.ReadTransactionByHash(hash)
+	transaction, err := r.getLoaders(ctx).transactionsByHash.load(hash)
 	if err != nil {
 		return nil, err
 	}
@@ -65,105 +130,32 @@ func (r *queryResolver) QueryTransactionByHash(ctx context.Context, hash string)
 	return convertIndexerTransactionToGrapQLTransaction(transaction), nil
 }
 
-func (r *queryResolver) QueryTransactionsByHeight(ctx context.Context, height int, page *int, perPage *int) (*model.TransactionsResponse, error) {
-	options := &postgresdriver.ReadTransactionsByHeightOptions{
-		Page:    defaultPage,
-		PerPage: defaultPerPage,
-	}
+func (r *queryResolver) QueryTransactionsByHeight(ctx context.Context, height int, page *int, perPage *int, first *int, after *string, last *int, before *string) (*model.TransactionsResponse, error) {
+	args := newConnectionArgs(ctx, page, perPage, first, after, last, before)
 
-	if page != nil {
-		options.Page = *page
-	}
-	if perPage != nil {
-		options.PerPage = *perPage
-	}
-
-	transactions, err := r.Reader.ReadTransactionsByHeight(height, options)
-	if err != nil {
-		return nil, err
-	}
-
-	quantity, err := r.Reader.GetTransactionsQuantityByHeight(height)
-	if err != nil {
-		return nil, err
-	}
-
-	return &model.TransactionsResponse{
-		Transactions: convertMultipleIndexerTransactionsToGrapQLTransactions(transactions),
-		Page:         options.Page,
-		TotalCount:   int(quantity),
-		PageCount:    len(transactions),
-		TotalPages:   getTotalPages(int(quantity), options.PerPage),
-	}, nil
+	return r.readTransactions(args, postgresdriver.AscendantOrder, &storage.TransactionFilter{Height: height})
 }
 
-func (r *queryResolver) QueryTransactions(ctx context.Context, page *int, perPage *int, order *postgresdriver.Order) (*model.TransactionsResponse, error) {
-	options := &postgresdriver.ReadTransactionsOptions{
-		Page:    defaultPage,
-		PerPage: defaultPerPage,
-		Order:   defaultOrder,
-	}
-
-	if page != nil {
-		options.Page = *page
-	}
-	if perPage != nil {
-		options.PerPage = *perPage
-	}
-	if order != nil {
-		options.Order = *order
-	}
-
-	transactions, err := r.Reader.ReadTransactions(options)
+func (r *queryResolver) QueryTransactions(ctx context.Context, page *int, perPage *int, order *postgresdriver.Order, filter *model.TransactionFilter, first *int, after *string, last *int, before *string) (*model.TransactionsResponse, error) {
+	transactionFilter, err := getTransactionFilter(filter, "")
 	if err != nil {
 		return nil, err
 	}
 
-	quantity, err := r.Reader.GetTransactionsQuantity()
-	if err != nil {
-		return nil, err
-	}
+	args := newConnectionArgs(ctx, page, perPage, first, after, last, before)
 
-	return &model.TransactionsResponse{
-		Transactions: convertMultipleIndexerTransactionsToGrapQLTransactions(transactions),
-		Page:         options.Page,
-		TotalCount:   int(quantity),
-		PageCount:    len(transactions),
-		TotalPages:   getTotalPages(int(quantity), options.PerPage),
-	}, nil
+	return r.readTransactions(args, getOrder(order, defaultOrder), transactionFilter)
 }
 
-func (r *queryResolver) QueryTransactionsByAddress(ctx context.Context, address string, page *int, perPage *int) (*model.TransactionsResponse, error) {
-	options := &postgresdriver.ReadTransactionsByAddressOptions{
-		Page:    defaultPage,
-		PerPage: defaultPerPage,
-	}
-
-	if page != nil {
-		options.Page = *page
-	}
-
-	if perPage != nil {
-		options.PerPage = *perPage
-	}
-
-	transactions, err := r.Reader.ReadTransactionsByAddress(address, options)
+func (r *queryResolver) QueryTransactionsByAddress(ctx context.Context, address string, page *int, perPage *int, order *postgresdriver.Order, filter *model.TransactionFilter, first *int, after *string, last *int, before *string) (*model.TransactionsResponse, error) {
+	transactionFilter, err := getTransactionFilter(filter, address)
 	if err != nil {
 		return nil, err
 	}
 
-	quantity, err := r.Reader.GetTransactionsQuantityByAddress(address)
-	if err != nil {
-		return nil, err
-	}
+	args := newConnectionArgs(ctx, page, perPage, first, after, last, before)
 
-	return &model.TransactionsResponse{
-		Transactions: convertMultipleIndexerTransactionsToGrapQLTransactions(transactions),
-		Page:         options.Page,
-		TotalCount:   int(quantity),
-		PageCount:    len(transactions),
-		TotalPages:   getTotalPages(int(quantity), options.PerPage),
-	}, nil
+	return r.readTransactions(args, getOrder(order, postgresdriver.DescendantOrder), transactionFilter)
 }
 
 func (r *queryResolver) QueryAccountByAddress(ctx context.Context, address string, height *int) (*model.GraphQLAccount, error) {
@@ -181,10 +173,15 @@ func (r *queryResolver) QueryAccountByAddress(ctx context.Context, address strin
 	return convertIndexerAccountToGraphQLAccount(account), nil
 }
 
-func (r *queryResolver) QueryAccounts(ctx context.Context, height *int, page *int, perPage *int) (*model.AccountsResponse, error) {
+func (r *queryResolver) QueryAccounts(ctx context.Context, height *int, page *int, perPage *int, first *int, after *string, last *int, before *string) (*model.AccountsResponse, error) {
+	args := newConnectionArgs(ctx, page, perPage, first, after, last, before)
+	if args.isCursorPage() {
+		return r.readAccountsCursorPage(args, height)
+	}
+
 	readOptions := &postgresdriver.ReadAccountsOptions{
 		Page:    defaultPage,
-		PerPage: defaultPerPage,
+		PerPage: r.getDefaultPerPage(),
 	}
 	quantityOptions := &postgresdriver.GetAccountsQuantityOptions{}
 
@@ -211,12 +208,18 @@ func (r *queryResolver) QueryAccounts(ctx context.Context, height *int, page *in
 		return nil, err
 	}
 
+	graphqlAccounts := convertMultipleIndexerAccountToGraphQLAccount(accounts)
+	edges, cursors := getAccountEdges(graphqlAccounts)
+	totalPages := getTotalPages(int(quantity), readOptions.PerPage)
+
 	return &model.AccountsResponse{
-		Accounts:   convertMultipleIndexerAccountToGraphQLAccount(accounts),
+		Accounts:   graphqlAccounts,
 		Page:       readOptions.Page,
 		TotalCount: int(quantity),
 		PageCount:  len(accounts),
-		TotalPages: getTotalPages(int(quantity), readOptions.PerPage),
+		TotalPages: totalPages,
+		Edges:      edges,
+		PageInfo:   getOffsetPageInfo(readOptions.Page, readOptions.Page < totalPages, cursors),
 	}, nil
 }
 
@@ -235,10 +238,15 @@ func (r *queryResolver) QueryNodeByAddress(ctx context.Context, address string,
 	return convertIndexerNodeToGraphQLNode(node), nil
 }
 
-func (r *queryResolver) QueryNodes(ctx context.Context, height *int, page *int, perPage *int) (*model.NodesResponse, error) {
+func (r *queryResolver) QueryNodes(ctx context.Context, height *int, page *int, perPage *int, first *int, after *string, last *int, before *string) (*model.NodesResponse, error) {
+	args := newConnectionArgs(ctx, page, perPage, first, after, last, before)
+	if args.isCursorPage() {
+		return r.readNodesCursorPage(args, height)
+	}
+
 	readOptions := &postgresdriver.ReadNodesOptions{
 		Page:    defaultPage,
-		PerPage: defaultPerPage,
+		PerPage: r.getDefaultPerPage(),
 	}
 	quantityOptions := &postgresdriver.GetNodesQuantityOptions{}
 
@@ -265,12 +273,18 @@ func (r *queryResolver) QueryNodes(ctx context.Context, height *int, page *int,
 		return nil, err
 	}
 
+	graphqlNodes := convertMultipleIndexerNodeToGraphQLNode(nodes)
+	edges, cursors := getNodeEdges(graphqlNodes)
+	totalPages := getTotalPages(int(quantity), readOptions.PerPage)
+
 	return &model.NodesResponse{
-		Nodes:      convertMultipleIndexerNodeToGraphQLNode(nodes),
+		Nodes:      graphqlNodes,
 		Page:       readOptions.Page,
 		TotalCount: int(quantity),
 		PageCount:  len(nodes),
-		TotalPages: getTotalPages(int(quantity), readOptions.PerPage),
+		TotalPages: totalPages,
+		Edges:      edges,
+		PageInfo:   getOffsetPageInfo(readOptions.Page, readOptions.Page < totalPages, cursors),
 	}, nil
 }
 
@@ -289,10 +303,15 @@ func (r *queryResolver) QueryAppByAddress(ctx context.Context, address string, h
 	return convertIndexerAppToGraphQLApp(app), nil
 }
 
-func (r *queryResolver) QueryApps(ctx context.Context, height *int, page *int, perPage *int) (*model.AppsResponse, error) {
+func (r *queryResolver) QueryApps(ctx context.Context, height *int, page *int, perPage *int, first *int, after *string, last *int, before *string) (*model.AppsResponse, error) {
+	args := newConnectionArgs(ctx, page, perPage, first, after, last, before)
+	if args.isCursorPage() {
+		return r.readAppsCursorPage(args, height)
+	}
+
 	readOptions := &postgresdriver.ReadAppsOptions{
 		Page:    defaultPage,
-		PerPage: defaultPerPage,
+		PerPage: r.getDefaultPerPage(),
 	}
 	quantityOptions := &postgresdriver.GetAppsQuantityOptions{}
 
@@ -319,16 +338,40 @@ func (r *queryResolver) QueryApps(ctx context.Context, height *int, page *int, p
 		return nil, err
 	}
 
+	graphqlApps := convertMultipleIndexeraAppToGraphQLApp(apps)
+	edges, cursors := getAppEdges(graphqlApps)
+	totalPages := getTotalPages(int(quantity), readOptions.PerPage)
+
 	return &model.AppsResponse{
-		Apps:       convertMultipleIndexeraAppToGraphQLApp(apps),
+		Apps:       graphqlApps,
 		Page:       readOptions.Page,
 		TotalCount: int(quantity),
 		PageCount:  len(apps),
-		TotalPages: getTotalPages(int(quantity), readOptions.PerPage),
+		TotalPages: totalPages,
+		Edges:      edges,
+		PageInfo:   getOffsetPageInfo(readOptions.Page, readOptions.Page < totalPages, cursors),
 	}, nil
 }
 
+// Block returns generated.BlockResolver implementation.
+func (r *Resolver) Block() generated.BlockResolver { return &blockResolver{r} }
+
+// GraphQLApp returns generated.GraphQLAppResolver implementation.
+func (r *Resolver) GraphQLApp() generated.GraphQLAppResolver { return &graphQLAppResolver{r} }
+
+// GraphQLNode returns generated.GraphQLNodeResolver implementation.
+func (r *Resolver) GraphQLNode() generated.GraphQLNodeResolver { return &graphQLNodeResolver{r} }
+
+// GraphQLTransaction returns generated.GraphQLTransactionResolver implementation.
+func (r *Resolver) GraphQLTransaction() generated.GraphQLTransactionResolver {
+	return &graphQLTransactionResolver{r}
+}
+
 // Query returns generated.QueryResolver implementation.
 func (r *Resolver) Query() generated.QueryResolver { return &queryResolver{r} }
 
+type blockResolver struct{ *Resolver }
+type graphQLAppResolver struct{ *Resolver }
+type graphQLNodeResolver struct{ *Resolver }
+type graphQLTransactionResolver struct{ *Resolver }
 type queryResolver struct{ *Resolver }
@@ -7,17 +7,12 @@ import (
 	"net/http"
 
 	"github.com/99designs/gqlgen/graphql/handler"
+	"github.com/99designs/gqlgen/graphql/handler/extension"
 	"github.com/99designs/gqlgen/graphql/playground"
-	postgresdriver "github.com/pokt-foundation/pocket-indexer-lib/postgres-driver"
 	"github.com/pokt-foundation/pocket-indexer-services/api/graph"
 	"github.com/pokt-foundation/pocket-indexer-services/api/graph/generated"
-	"github.com/pokt-foundation/utils-go/environment"
-)
-
-var (
-	connectionString = environment.GetString("CONNECTION_STRING", "")
-	port             = environment.GetString("PORT", "8080")
-	runPlayground    = environment.GetBool("RUN_PLAYGROUND", true)
+	"github.com/pokt-foundation/pocket-indexer-services/config"
+	"github.com/pokt-foundation/pocket-indexer-services/storage"
 )
 
 func healthCheck() http.HandlerFunc {
@@ -30,20 +25,57 @@ func healthCheck() http.HandlerFunc {
 	}
 }
 
+func loadConfig() (*config.Config, error) {
+	apiConfig, err := config.Load(config.GetFilePath())
+	if err != nil {
+		return nil, err
+	}
+
+	err = apiConfig.ResolveSecrets()
+	if err != nil {
+		return nil, err
+	}
+
+	err = apiConfig.ValidateAPI()
+	if err != nil {
+		return nil, err
+	}
+
+	return apiConfig, nil
+}
+
 func main() {
-	driver, err := postgresdriver.NewPostgresDriverFromConnectionString(connectionString)
+	apiConfig, err := loadConfig()
+	if err != nil {
+		panic(fmt.Sprintf("load configuration failed with error: %s", err.Error()))
+	}
+
+	port := apiConfig.API.Port
+
+	driver, err := storage.NewPostgresDriverFromConnectionString(apiConfig.ConnectionString)
 	if err != nil {
 		panic(fmt.Sprintf("connection to database failed with error: %s", err.Error()))
 	}
 
-	srv := handler.NewDefaultServer(generated.NewExecutableSchema(generated.Config{Resolvers: &graph.Resolver{
-		Reader: driver,
-	}}))
+	resolver := &graph.Resolver{
+		Reader:      driver,
+		MaxPageSize: int(apiConfig.API.MaxPageSize),
+	}
+
+	srv := handler.NewDefaultServer(generated.NewExecutableSchema(generated.Config{
+		Resolvers:  resolver,
+		Complexity: resolver.NewComplexity(),
+	}))
+	srv.Use(graph.QueryLimits{
+		MaxDepth:    int(apiConfig.API.MaxDepth),
+		MaxPageSize: int(apiConfig.API.MaxPageSize),
+	})
+	srv.Use(extension.FixedComplexityLimit(int(apiConfig.API.MaxComplexity)))
 
 	http.Handle("/", healthCheck())
-	http.Handle("/query", srv)
+	http.Handle("/query", resolver.WithLoaders(srv))
 
-	if runPlayground {
+	if apiConfig.API.RunPlayground {
 		http.Handle("/playground", playground.Handler("GraphQL playground", "/query"))
 		log.Printf("connect to http://localhost:%s/playground for GraphQL playground", port)
 	}
@@ -0,0 +1,317 @@
+// Package config loads the configuration shared by the indexer service and the API
+// from the env vars and an optional YAML file whose values override them
+package config
+
+import (
+	"bytes"
+	"errors"
+	"io"
+	"os"
+	"strings"
+
+	"github.com/pokt-foundation/pocket-indexer-services/storage"
+	"github.com/pokt-foundation/utils-go/environment"
+	"gopkg.in/yaml.v3"
+)
+
+const (
+	secretEnvPrefix  = "env:"
+	secretFilePrefix = "file:"
+)
+
+var (
+	// ErrSecretNotFound error when the env var referenced by a secret is not set
+	ErrSecretNotFound = errors.New("secret env var not set")
+)
+
+// Config struct handler for the configuration of both binaries, Path is the file it was loaded from
+// ConnectionString can reference a secret as env:NAME or file:/path instead of holding it
+type Config struct {
+	Path             string  `yaml:"-"`
+	ConnectionString string  `yaml:"connectionString"`
+	Service          Service `yaml:"service"`
+	API              API     `yaml:"api"`
+}
+
+// Service struct handler for the configuration of the indexer service, durations are in milliseconds
+// Stages are the stages indexed, accounts being the fan-out of the transactions, nodes and apps accounts,
+// which are indexed by AccountConcurrency workers of their own instead of taking Concurrency units
+// SnapshotAddresses are the addresses whose accounts the snapshot command indexes every SnapshotStep heights
+// The failed heights out of attempts are also appended as JSON lines to DeadLetterFile when it is set
+// Concurrency, RequestInterval and the adaptive concurrency bounds are reloaded while running, the rest applies on restart
+type Service struct {
+	MainNode             string              `yaml:"mainNode"`
+	FallbackNode         string              `yaml:"fallbackNode"`
+	Nodes                []string            `yaml:"nodes"`
+	Stages               []string            `yaml:"stages"`
+	ClientTimeout        int64               `yaml:"clientTimeout"`
+	ClientRetries        int64               `yaml:"clientRetries"`
+	ServiceRetries       int64               `yaml:"serviceRetries"`
+	Concurrency          int64               `yaml:"concurrency"`
+	AccountConcurrency   int64               `yaml:"accountConcurrency"`
+	AccountQueueSize     int64               `yaml:"accountQueueSize"`
+	RequestInterval      int64               `yaml:"requestInterval"`
+	QueueSize            int64               `yaml:"queueSize"`
+	CheckpointInterval   int64               `yaml:"checkpointInterval"`
+	FromHeight           int                 `yaml:"fromHeight"`
+	ToHeight             int                 `yaml:"toHeight"`
+	BackfillGaps         bool                `yaml:"backfillGaps"`
+	SnapshotAddresses    []string            `yaml:"snapshotAddresses"`
+	SnapshotStep         int64               `yaml:"snapshotStep"`
+	GapSweepInterval     int64               `yaml:"gapSweepInterval"`
+	ReorgCheckDepth      int64               `yaml:"reorgCheckDepth"`
+	ShutdownTimeout      int64               `yaml:"shutdownTimeout"`
+	Port                 string              `yaml:"port"`
+	LivenessTimeout      int64               `yaml:"livenessTimeout"`
+	ReadinessMaxLag      int64               `yaml:"readinessMaxLag"`
+	ConfigReloadInterval int64               `yaml:"configReloadInterval"`
+	DeadLetterFile       string              `yaml:"deadLetterFile"`
+	Retry                Retry               `yaml:"retry"`
+	NodePool             NodePool            `yaml:"nodePool"`
+	Redrive              Redrive             `yaml:"redrive"`
+	AdaptiveConcurrency  AdaptiveConcurrency `yaml:"adaptiveConcurrency"`
+	Leases               Leases              `yaml:"leases"`
+	LeaderElection       LeaderElection      `yaml:"leaderElection"`
+}
+
+// Retry struct handler for the backoff of the indexing calls
+type Retry struct {
+	InitialInterval int64 `yaml:"initialInterval"`
+	MaxInterval     int64 `yaml:"maxInterval"`
+	MaxElapsedTime  int64 `yaml:"maxElapsedTime"`
+}
+
+// NodePool struct handler for the circuit breaking and rate limiting of the nodes,
+// RateLimit applies to every node but the ones with their own in NodeRateLimits, keyed by url
+type NodePool struct {
+	FailureThreshold int64                `yaml:"failureThreshold"`
+	OpenDuration     int64                `yaml:"openDuration"`
+	ProbeInterval    int64                `yaml:"probeInterval"`
+	RateLimit        RateLimit            `yaml:"rateLimit"`
+	NodeRateLimits   map[string]RateLimit `yaml:"nodeRateLimits"`
+}
+
+// RateLimit struct handler for the requests per second allowed to a node, 0 disables the limit,
+// Burst is the requests that can be made at once after the node was idle
+type RateLimit struct {
+	RequestsPerSecond int64 `yaml:"requestsPerSecond"`
+	Burst             int64 `yaml:"burst"`
+}
+
+// Redrive struct handler for the retries of the failed heights
+type Redrive struct {
+	Interval         int64 `yaml:"interval"`
+	BatchSize        int64 `yaml:"batchSize"`
+	RetryInterval    int64 `yaml:"retryInterval"`
+	MaxRetryInterval int64 `yaml:"maxRetryInterval"`
+	MaxAttempts      int64 `yaml:"maxAttempts"`
+}
+
+// AdaptiveConcurrency struct handler for the controller of the concurrency, when enabled Concurrency is
+// only the initial limit, increased every interval the nodes are healthy and cut when they are overloaded
+type AdaptiveConcurrency struct {
+	Enabled         bool  `yaml:"enabled"`
+	MinConcurrency  int64 `yaml:"minConcurrency"`
+	MaxConcurrency  int64 `yaml:"maxConcurrency"`
+	Increase        int64 `yaml:"increase"`
+	DecreasePercent int64 `yaml:"decreasePercent"`
+	LatencyTarget   int64 `yaml:"latencyTarget"`
+	MaxErrorPercent int64 `yaml:"maxErrorPercent"`
+	Interval        int64 `yaml:"interval"`
+}
+
+// Leases struct handler for the height ranges leased while following, when enabled the replicas sharing
+// the database split the heights by claiming ranges of RangeSize heights, renewed every HeartbeatInterval
+// while they are indexed, the ranges of a replica that stops renewing them are reclaimed after Duration
+// Owner identifies the replica, its hostname and process id when it is not set
+// The gap sweeps and the block hash checks need a single replica so they don't run while leasing
+type Leases struct {
+	Enabled           bool   `yaml:"enabled"`
+	Owner             string `yaml:"owner"`
+	RangeSize         int64  `yaml:"rangeSize"`
+	Duration          int64  `yaml:"duration"`
+	HeartbeatInterval int64  `yaml:"heartbeatInterval"`
+}
+
+// LeaderElection struct handler for the election of the replica following the tip, when enabled only the replica
+// holding the leader lock follows it while the others wait as standbys, trying to take the lock every RetryInterval
+// The leader checks it still holds the lock every CheckInterval and stops once it lost it
+type LeaderElection struct {
+	Enabled       bool  `yaml:"enabled"`
+	RetryInterval int64 `yaml:"retryInterval"`
+	CheckInterval int64 `yaml:"checkInterval"`
+}
+
+// API struct handler for the configuration of the GraphQL API
+// The operations nesting fields deeper than MaxDepth, costing more than MaxComplexity or asking for pages
+// of more than MaxPageSize rows are rejected, every field costs 1 and the fields of a list once per row of its page
+type API struct {
+	Port          string `yaml:"port"`
+	RunPlayground bool   `yaml:"runPlayground"`
+	MaxDepth      int64  `yaml:"maxDepth"`
+	MaxComplexity int64  `yaml:"maxComplexity"`
+	MaxPageSize   int64  `yaml:"maxPageSize"`
+}
+
+// GetFilePath returns the path of the config file set in the CONFIG_FILE env var, empty when there is none
+func GetFilePath() string {
+	return environment.GetString("CONFIG_FILE", "")
+}
+
+// FromEnv returns the configuration set by the env vars, their defaults when they are not set
+func FromEnv() *Config {
+	return &Config{
+		ConnectionString: environment.GetString("CONNECTION_STRING", ""),
+		Service: Service{
+			MainNode:             environment.GetString("MAIN_NODE", ""),
+			FallbackNode:         environment.GetString("FALLBACK_NODE", ""),
+			Nodes:                splitList(environment.GetString("NODES", "")),
+			Stages:               splitList(environment.GetString("STAGES", strings.Join(storage.Stages, ","))),
+			ClientTimeout:        environment.GetInt64("CLIENT_TIMEOUT", 60000),
+			ClientRetries:        environment.GetInt64("CLIENT_RETRIES", 3),
+			ServiceRetries:       environment.GetInt64("SERVICE_RETRIES", 3),
+			Concurrency:          environment.GetInt64("CONCURRENCY", 100),
+			AccountConcurrency:   environment.GetInt64("ACCOUNT_CONCURRENCY", 20),
+			AccountQueueSize:     environment.GetInt64("ACCOUNT_QUEUE_SIZE", 1000),
+			RequestInterval:      environment.GetInt64("REQUEST_INTERVAL", 5000),
+			QueueSize:            environment.GetInt64("QUEUE_SIZE", 1000),
+			CheckpointInterval:   environment.GetInt64("CHECKPOINT_INTERVAL", 5000),
+			FromHeight:           int(environment.GetInt64("FROM_HEIGHT", -1)),
+			ToHeight:             int(environment.GetInt64("TO_HEIGHT", -1)),
+			BackfillGaps:         environment.GetBool("BACKFILL_GAPS", false),
+			SnapshotAddresses:    splitList(environment.GetString("SNAPSHOT_ADDRESSES", "")),
+			SnapshotStep:         environment.GetInt64("SNAPSHOT_STEP", 1),
+			GapSweepInterval:     environment.GetInt64("GAP_SWEEP_INTERVAL", 3600000),
+			ReorgCheckDepth:      environment.GetInt64("REORG_CHECK_DEPTH", 10),
+			ShutdownTimeout:      environment.GetInt64("SHUTDOWN_TIMEOUT", 25000),
+			Port:                 environment.GetString("SERVICE_PORT", "9090"),
+			LivenessTimeout:      environment.GetInt64("LIVENESS_TIMEOUT", 300000),
+			ReadinessMaxLag:      environment.GetInt64("READINESS_MAX_LAG", 100),
+			ConfigReloadInterval: environment.GetInt64("CONFIG_RELOAD_INTERVAL", 30000),
+			DeadLetterFile:       environment.GetString("DEAD_LETTER_FILE", ""),
+			Retry: Retry{
+				InitialInterval: environment.GetInt64("RETRY_INITIAL_INTERVAL", 500),
+				MaxInterval:     environment.GetInt64("RETRY_MAX_INTERVAL", 10000),
+				MaxElapsedTime:  environment.GetInt64("RETRY_MAX_ELAPSED_TIME", 60000),
+			},
+			NodePool: NodePool{
+				FailureThreshold: environment.GetInt64("NODE_FAILURE_THRESHOLD", 5),
+				OpenDuration:     environment.GetInt64("NODE_OPEN_DURATION", 30000),
+				ProbeInterval:    environment.GetInt64("NODE_PROBE_INTERVAL", 10000),
+				RateLimit: RateLimit{
+					RequestsPerSecond: environment.GetInt64("NODE_REQUESTS_PER_SECOND", 0),
+					Burst:             environment.GetInt64("NODE_BURST", 10),
+				},
+			},
+			Redrive: Redrive{
+				Interval:         environment.GetInt64("REDRIVE_INTERVAL", 60000),
+				BatchSize:        environment.GetInt64("REDRIVE_BATCH_SIZE", 100),
+				RetryInterval:    environment.GetInt64("REDRIVE_RETRY_INTERVAL", 30000),
+				MaxRetryInterval: environment.GetInt64("REDRIVE_MAX_RETRY_INTERVAL", 3600000),
+				MaxAttempts:      environment.GetInt64("REDRIVE_MAX_ATTEMPTS", 10),
+			},
+			AdaptiveConcurrency: AdaptiveConcurrency{
+				Enabled:         environment.GetBool("ADAPTIVE_CONCURRENCY", false),
+				MinConcurrency:  environment.GetInt64("MIN_CONCURRENCY", 20),
+				MaxConcurrency:  environment.GetInt64("MAX_CONCURRENCY", 500),
+				Increase:        environment.GetInt64("CONCURRENCY_INCREASE", 5),
+				DecreasePercent: environment.GetInt64("CONCURRENCY_DECREASE_PERCENT", 30),
+				LatencyTarget:   environment.GetInt64("CONCURRENCY_LATENCY_TARGET", 2000),
+				MaxErrorPercent: environment.GetInt64("CONCURRENCY_MAX_ERROR_PERCENT", 5),
+				Interval:        environment.GetInt64("CONCURRENCY_ADJUST_INTERVAL", 10000),
+			},
+			Leases: Leases{
+				Enabled:           environment.GetBool("LEASES_ENABLED", false),
+				Owner:             environment.GetString("LEASE_OWNER", ""),
+				RangeSize:         environment.GetInt64("LEASE_RANGE_SIZE", 1000),
+				Duration:          environment.GetInt64("LEASE_DURATION", 60000),
+				HeartbeatInterval: environment.GetInt64("LEASE_HEARTBEAT_INTERVAL", 10000),
+			},
+			LeaderElection: LeaderElection{
+				Enabled:       environment.GetBool("LEADER_ELECTION", false),
+				RetryInterval: environment.GetInt64("LEADER_RETRY_INTERVAL", 5000),
+				CheckInterval: environment.GetInt64("LEADER_CHECK_INTERVAL", 5000),
+			},
+		},
+		API: API{
+			// PORT is still read for the API set up before API_PORT existed
+			Port:          environment.GetString("API_PORT", environment.GetString("PORT", "8080")),
+			RunPlayground: environment.GetBool("RUN_PLAYGROUND", true),
+			MaxDepth:      environment.GetInt64("API_MAX_DEPTH", 12),
+			MaxComplexity: environment.GetInt64("API_MAX_COMPLEXITY", 100000),
+			MaxPageSize:   environment.GetInt64("API_MAX_PAGE_SIZE", 1000),
+		},
+	}
+}
+
+// Load returns the configuration of the env vars overridden by the YAML file in given path, if any
+// Unknown fields in the file are rejected so a typo does not silently fall back to the env var
+func Load(path string) (*Config, error) {
+	config := FromEnv()
+	config.Path = path
+
+	if path != "" {
+		content, err := os.ReadFile(path)
+		if err != nil {
+			return nil, err
+		}
+
+		decoder := yaml.NewDecoder(bytes.NewReader(content))
+		decoder.KnownFields(true)
+
+		err = decoder.Decode(config)
+		if err != nil && !errors.Is(err, io.EOF) {
+			return nil, err
+		}
+	}
+
+	return config, nil
+}
+
+// ResolveSecrets replaces the secret references of the configuration with their values
+func (c *Config) ResolveSecrets() error {
+	connectionString, err := ResolveSecret(c.ConnectionString)
+	if err != nil {
+		return err
+	}
+
+	c.ConnectionString = connectionString
+
+	return nil
+}
+
+// ResolveSecret returns the value referenced by an env:NAME or file:/path secret,
+// any other value is returned as it is
+func ResolveSecret(value string) (string, error) {
+	switch {
+	case strings.HasPrefix(value, secretEnvPrefix):
+		secret, ok := os.LookupEnv(strings.TrimPrefix(value, secretEnvPrefix))
+		if !ok {
+			return "", ErrSecretNotFound
+		}
+
+		return secret, nil
+	case strings.HasPrefix(value, secretFilePrefix):
+		secret, err := os.ReadFile(strings.TrimPrefix(value, secretFilePrefix))
+		if err != nil {
+			return "", err
+		}
+
+		return strings.TrimSpace(string(secret)), nil
+	default:
+		return value, nil
+	}
+}
+
+func splitList(list string) []string {
+	var items []string
+
+	for _, item := range strings.Split(list, ",") {
+		item = strings.TrimSpace(item)
+		if item != "" {
+			items = append(items, item)
+		}
+	}
+
+	return items
+}
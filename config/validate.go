@@ -0,0 +1,286 @@
+package config
+
+import (
+	"errors"
+	"fmt"
+	"net/url"
+
+	"github.com/pokt-foundation/pocket-indexer-services/storage"
+)
+
+var (
+	// ErrInvalidConfig error wrapped by every validation error, which names the field that failed
+	ErrInvalidConfig = errors.New("invalid config")
+)
+
+type numberField struct {
+	name  string
+	value int64
+}
+
+// ValidateService checks the configuration of the indexer service
+func (c *Config) ValidateService() error {
+	validators := []func() error{
+		c.validateConnectionString,
+		c.Service.validateNodes,
+		c.Service.validateStages,
+		c.Service.validateRateLimits,
+		c.Service.validateNumbers,
+		c.Service.AdaptiveConcurrency.validate,
+		c.Service.validateCoordination,
+	}
+
+	for _, validate := range validators {
+		err := validate()
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// validateNumbers checks the port, the numbers that can't be zero or negative and the intervals bounded by others
+func (s *Service) validateNumbers() error {
+	if s.Port == "" {
+		return invalidField("service.port", "is required")
+	}
+
+	err := validatePositive(s.getPositiveFields())
+	if err != nil {
+		return err
+	}
+
+	err = validateNonNegative(s.getNonNegativeFields())
+	if err != nil {
+		return err
+	}
+
+	if s.Retry.MaxInterval < s.Retry.InitialInterval {
+		return invalidField("service.retry.maxInterval", "is lower than service.retry.initialInterval")
+	}
+
+	if s.Redrive.MaxRetryInterval < s.Redrive.RetryInterval {
+		return invalidField("service.redrive.maxRetryInterval", "is lower than service.redrive.retryInterval")
+	}
+
+	if s.Leases.Duration <= s.Leases.HeartbeatInterval {
+		return invalidField("service.leases.duration", "must be greater than service.leases.heartbeatInterval")
+	}
+
+	return nil
+}
+
+// ValidateAPI checks the configuration of the GraphQL API
+func (c *Config) ValidateAPI() error {
+	err := c.validateConnectionString()
+	if err != nil {
+		return err
+	}
+
+	if c.API.Port == "" {
+		return invalidField("api.port", "is required")
+	}
+
+	return validatePositive([]numberField{
+		{name: "api.maxDepth", value: c.API.MaxDepth},
+		{name: "api.maxComplexity", value: c.API.MaxComplexity},
+		{name: "api.maxPageSize", value: c.API.MaxPageSize},
+	})
+}
+
+func (c *Config) validateConnectionString() error {
+	if c.ConnectionString == "" {
+		return invalidField("connectionString", "is required")
+	}
+
+	return nil
+}
+
+func (s *Service) validateNodes() error {
+	nodeURLs := append([]string{s.MainNode, s.FallbackNode}, s.Nodes...)
+	hasNode := false
+
+	for _, nodeURL := range nodeURLs {
+		if nodeURL == "" {
+			continue
+		}
+
+		parsedURL, err := url.Parse(nodeURL)
+		if err != nil || (parsedURL.Scheme != "http" && parsedURL.Scheme != "https") || parsedURL.Host == "" {
+			return invalidField("service.nodes", fmt.Sprintf("has an invalid url %q", nodeURL))
+		}
+
+		hasNode = true
+	}
+
+	if !hasNode {
+		return invalidField("service.nodes", "needs at least one node url")
+	}
+
+	return nil
+}
+
+func (s *Service) validateStages() error {
+	if len(s.Stages) == 0 {
+		return invalidField("service.stages", "needs at least one stage")
+	}
+
+	knownStages := make(map[string]bool, len(storage.Stages))
+	for _, stage := range storage.Stages {
+		knownStages[stage] = true
+	}
+
+	for _, stage := range s.Stages {
+		if !knownStages[stage] {
+			return invalidField("service.stages", fmt.Sprintf("has an unknown or repeated stage %q", stage))
+		}
+
+		// Deleting it makes a repeated stage fail as unknown
+		delete(knownStages, stage)
+	}
+
+	return nil
+}
+
+// validateRateLimits checks the rate limits, the ones of a single node must belong to a configured node
+func (s *Service) validateRateLimits() error {
+	err := s.NodePool.RateLimit.validate("service.nodePool.rateLimit")
+	if err != nil {
+		return err
+	}
+
+	nodeURLs := append([]string{s.MainNode, s.FallbackNode}, s.Nodes...)
+
+	for nodeURL, rateLimit := range s.NodePool.NodeRateLimits {
+		if nodeURL == "" || !containsString(nodeURLs, nodeURL) {
+			return invalidField("service.nodePool.nodeRateLimits", fmt.Sprintf("has an unknown node url %q", nodeURL))
+		}
+
+		err = rateLimit.validate(fmt.Sprintf("service.nodePool.nodeRateLimits[%s]", nodeURL))
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (r *RateLimit) validate(name string) error {
+	err := validateNonNegative([]numberField{{name: name + ".requestsPerSecond", value: r.RequestsPerSecond}})
+	if err != nil {
+		return err
+	}
+
+	return validatePositive([]numberField{{name: name + ".burst", value: r.Burst}})
+}
+
+func (a *AdaptiveConcurrency) validate() error {
+	if a.MaxConcurrency < a.MinConcurrency {
+		return invalidField("service.adaptiveConcurrency.maxConcurrency", "is lower than service.adaptiveConcurrency.minConcurrency")
+	}
+
+	if a.DecreasePercent >= 100 {
+		return invalidField("service.adaptiveConcurrency.decreasePercent", "must be lower than 100")
+	}
+
+	if a.MaxErrorPercent > 100 {
+		return invalidField("service.adaptiveConcurrency.maxErrorPercent", "must not be greater than 100")
+	}
+
+	return nil
+}
+
+// validateCoordination checks the replicas are coordinated by either leader election or leases,
+// the leased ranges already split the tip between the replicas
+func (s *Service) validateCoordination() error {
+	if s.LeaderElection.Enabled && s.Leases.Enabled {
+		return invalidField("service.leaderElection.enabled", "can't be combined with service.leases.enabled")
+	}
+
+	return nil
+}
+
+func (s *Service) getPositiveFields() []numberField {
+	return []numberField{
+		{name: "service.clientTimeout", value: s.ClientTimeout},
+		{name: "service.serviceRetries", value: s.ServiceRetries},
+		{name: "service.concurrency", value: s.Concurrency},
+		{name: "service.accountConcurrency", value: s.AccountConcurrency},
+		{name: "service.accountQueueSize", value: s.AccountQueueSize},
+		{name: "service.requestInterval", value: s.RequestInterval},
+		{name: "service.queueSize", value: s.QueueSize},
+		{name: "service.snapshotStep", value: s.SnapshotStep},
+		{name: "service.checkpointInterval", value: s.CheckpointInterval},
+		{name: "service.shutdownTimeout", value: s.ShutdownTimeout},
+		{name: "service.livenessTimeout", value: s.LivenessTimeout},
+		{name: "service.configReloadInterval", value: s.ConfigReloadInterval},
+		{name: "service.retry.initialInterval", value: s.Retry.InitialInterval},
+		{name: "service.retry.maxInterval", value: s.Retry.MaxInterval},
+		{name: "service.retry.maxElapsedTime", value: s.Retry.MaxElapsedTime},
+		{name: "service.nodePool.failureThreshold", value: s.NodePool.FailureThreshold},
+		{name: "service.nodePool.openDuration", value: s.NodePool.OpenDuration},
+		{name: "service.nodePool.probeInterval", value: s.NodePool.ProbeInterval},
+		{name: "service.redrive.interval", value: s.Redrive.Interval},
+		{name: "service.redrive.batchSize", value: s.Redrive.BatchSize},
+		{name: "service.redrive.retryInterval", value: s.Redrive.RetryInterval},
+		{name: "service.redrive.maxRetryInterval", value: s.Redrive.MaxRetryInterval},
+		{name: "service.redrive.maxAttempts", value: s.Redrive.MaxAttempts},
+		{name: "service.adaptiveConcurrency.minConcurrency", value: s.AdaptiveConcurrency.MinConcurrency},
+		{name: "service.adaptiveConcurrency.maxConcurrency", value: s.AdaptiveConcurrency.MaxConcurrency},
+		{name: "service.adaptiveConcurrency.increase", value: s.AdaptiveConcurrency.Increase},
+		{name: "service.adaptiveConcurrency.decreasePercent", value: s.AdaptiveConcurrency.DecreasePercent},
+		{name: "service.adaptiveConcurrency.latencyTarget", value: s.AdaptiveConcurrency.LatencyTarget},
+		{name: "service.adaptiveConcurrency.interval", value: s.AdaptiveConcurrency.Interval},
+		{name: "service.leases.rangeSize", value: s.Leases.RangeSize},
+		{name: "service.leases.duration", value: s.Leases.Duration},
+		{name: "service.leases.heartbeatInterval", value: s.Leases.HeartbeatInterval},
+		{name: "service.leaderElection.retryInterval", value: s.LeaderElection.RetryInterval},
+		{name: "service.leaderElection.checkInterval", value: s.LeaderElection.CheckInterval},
+	}
+}
+
+// getNonNegativeFields returns the fields where 0 is valid, for the intervals and depths it disables the feature
+func (s *Service) getNonNegativeFields() []numberField {
+	return []numberField{
+		{name: "service.clientRetries", value: s.ClientRetries},
+		{name: "service.gapSweepInterval", value: s.GapSweepInterval},
+		{name: "service.reorgCheckDepth", value: s.ReorgCheckDepth},
+		{name: "service.readinessMaxLag", value: s.ReadinessMaxLag},
+		{name: "service.adaptiveConcurrency.maxErrorPercent", value: s.AdaptiveConcurrency.MaxErrorPercent},
+	}
+}
+
+func validatePositive(fields []numberField) error {
+	for _, field := range fields {
+		if field.value <= 0 {
+			return invalidField(field.name, "must be greater than 0")
+		}
+	}
+
+	return nil
+}
+
+func validateNonNegative(fields []numberField) error {
+	for _, field := range fields {
+		if field.value < 0 {
+			return invalidField(field.name, "must not be negative")
+		}
+	}
+
+	return nil
+}
+
+func containsString(values []string, value string) bool {
+	for _, v := range values {
+		if v == value {
+			return true
+		}
+	}
+
+	return false
+}
+
+func invalidField(name, reason string) error {
+	return fmt.Errorf("%w: %s %s", ErrInvalidConfig, name, reason)
+}
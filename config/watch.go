@@ -0,0 +1,40 @@
+package config
+
+import (
+	"context"
+	"os"
+	"time"
+)
+
+// Watch polls the file in given path every interval until ctx is cancelled,
+// calling onChange each time the file changes so the configuration can be loaded again
+func Watch(ctx context.Context, path string, interval time.Duration, onChange func()) {
+	lastModTime := getModTime(path)
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-time.After(interval):
+		}
+
+		modTime := getModTime(path)
+		if modTime.Equal(lastModTime) {
+			continue
+		}
+
+		lastModTime = modTime
+
+		onChange()
+	}
+}
+
+// getModTime returns the zero time when the file can't be read so its removal counts as a change
+func getModTime(path string) time.Time {
+	info, err := os.Stat(path)
+	if err != nil {
+		return time.Time{}
+	}
+
+	return info.ModTime()
+}
@@ -0,0 +1,127 @@
+package main
+
+import (
+	"context"
+	"sync"
+
+	indexerlib "github.com/pokt-foundation/pocket-indexer-lib"
+	"github.com/pokt-foundation/pocket-indexer-services/storage"
+)
+
+// accountTask is an account to index at a height, the height is part of the deduplication key on purpose:
+// accounts are saved as a snapshot per height, which the gap detection expects at every height an address
+// took part in and the account of a transaction, node or app is read as of, so collapsing an address touched
+// at many heights in flight into its highest one would leave the lower heights missing and backfilled again
+type accountTask struct {
+	height      int
+	address     string
+	accountType indexerlib.AccountType
+}
+
+// accountQueue holds the accounts fanned out by the transactions, nodes and apps stages for the account workers,
+// which index them outside the semaphore so a stage queueing its accounts never waits for a slot
+// held by another stage, the accounts make progress whatever the concurrency is
+// An account already queued or running at a height is not queued again, the same address at other heights is
+// since each height keeps its own snapshot of the balance
+type accountQueue struct {
+	tasks    chan *accountTask
+	mu       sync.Mutex
+	inFlight map[accountTask]bool
+}
+
+func newAccountQueue(size int) *accountQueue {
+	return &accountQueue{
+		tasks:    make(chan *accountTask, size),
+		inFlight: make(map[accountTask]bool),
+	}
+}
+
+// queueAccount blocks until the account is queued, returning false when ctx is cancelled first
+func (s *service) queueAccount(ctx context.Context, task *accountTask) bool {
+	if !s.accountQueue.mark(task) {
+		accountsDeduplicatedCounter.Inc()
+		return true
+	}
+
+	s.indexingProcesses.Add(1)
+	s.heightsInFlight.add(task.height, storage.StageAccounts, 1)
+
+	select {
+	case s.accountQueue.tasks <- task:
+		accountQueueGauge.Inc()
+		return true
+	case <-ctx.Done():
+		s.accountQueue.unmark(task)
+		s.releaseAccount(task.height)
+		return false
+	}
+}
+
+// mark reports whether the account was not queued or running yet at the height, marking it
+func (q *accountQueue) mark(task *accountTask) bool {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+
+	if q.inFlight[*task] {
+		return false
+	}
+
+	q.inFlight[*task] = true
+
+	return true
+}
+
+func (q *accountQueue) unmark(task *accountTask) {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+
+	delete(q.inFlight, *task)
+}
+
+// runAccountWorkers starts the workers indexing the queued accounts until stop is closed,
+// ctx only cancels the indexing so the accounts queued on shutdown are drained as aborted
+func (s *service) runAccountWorkers(ctx context.Context, stop <-chan struct{}) {
+	s.backgroundProcesses.Add(s.accountConcurrency)
+
+	for i := 0; i < s.accountConcurrency; i++ {
+		go s.indexQueuedAccounts(ctx, stop)
+	}
+}
+
+func (s *service) indexQueuedAccounts(ctx context.Context, stop <-chan struct{}) {
+	defer s.backgroundProcesses.Done()
+
+	for {
+		select {
+		case <-stop:
+			return
+		case task := <-s.accountQueue.tasks:
+			accountQueueGauge.Dec()
+
+			s.indexAccount(ctx, task)
+		}
+	}
+}
+
+// abortQueuedAccounts fails the accounts left queued once the workers stopped on a cancelled ctx,
+// which happens when the drain expired, so they are re-driven later instead of kept in flight
+func (s *service) abortQueuedAccounts(ctx context.Context) {
+	for {
+		select {
+		case task := <-s.accountQueue.tasks:
+			accountQueueGauge.Dec()
+
+			s.failStage(ctx, task.height, storage.StageAccounts, task.address, task.accountType, ctx.Err())
+			s.accountQueue.unmark(task)
+			s.releaseAccount(task.height)
+		default:
+			return
+		}
+	}
+}
+
+func (s *service) releaseAccount(height int) {
+	heartbeat()
+	s.heightsInFlight.done(height, storage.StageAccounts)
+	s.indexingProcesses.Done()
+}
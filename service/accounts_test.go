@@ -0,0 +1,102 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	indexerlib "github.com/pokt-foundation/pocket-indexer-lib"
+	"github.com/pokt-foundation/pocket-indexer-services/storage"
+	"github.com/stretchr/testify/require"
+)
+
+func TestService_QueueAccount(t *testing.T) {
+	c := require.New(t)
+
+	s := newTestService(&fakeIndexer{}, &fakeDriver{})
+	queue := s.accountQueue
+	task := &accountTask{height: 10, address: "a1", accountType: indexerlib.AccountTypeNode}
+
+	c.True(s.queueAccount(context.Background(), task))
+
+	// The same account at the same height is queued once, at another height it keeps its own snapshot
+	c.True(s.queueAccount(context.Background(), &accountTask{height: 10, address: "a1", accountType: indexerlib.AccountTypeNode}))
+	c.True(s.queueAccount(context.Background(), &accountTask{height: 11, address: "a1", accountType: indexerlib.AccountTypeNode}))
+	c.True(s.queueAccount(context.Background(), &accountTask{height: 10, address: "a1", accountType: indexerlib.AccountTypeApp}))
+	c.Len(queue.tasks, 3)
+
+	// Once indexed the account can be queued again
+	queue.unmark(<-queue.tasks)
+	s.releaseAccount(task.height)
+
+	c.True(s.queueAccount(context.Background(), task))
+	c.Len(queue.tasks, 3)
+
+	for len(queue.tasks) > 0 {
+		task := <-queue.tasks
+		queue.unmark(task)
+		s.releaseAccount(task.height)
+	}
+}
+
+func TestService_QueueAccountCancelled(t *testing.T) {
+	c := require.New(t)
+
+	s := newTestService(&fakeIndexer{}, &fakeDriver{})
+	s.accountQueue = newAccountQueue(1)
+	queue := s.accountQueue
+	c.True(s.queueAccount(context.Background(), &accountTask{height: 10, address: "a1"}))
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	// A full queue gives up on a cancelled ctx, releasing the account so it can be queued again
+	task := &accountTask{height: 10, address: "a2"}
+
+	c.False(s.queueAccount(ctx, task))
+	c.True(queue.mark(task))
+
+	queue.unmark(task)
+	queue.unmark(<-queue.tasks)
+	s.releaseAccount(10)
+}
+
+func TestService_FanOutAccounts(t *testing.T) {
+	c := require.New(t)
+
+	// Every stage fans out more accounts than the queue holds while it keeps the only slot of the semaphore
+	indexer := &fakeIndexer{
+		indexNodes: func(height int) ([]string, error) {
+			var addresses []string
+			for i := 0; i < 20; i++ {
+				addresses = append(addresses, fmt.Sprintf("node-%d", i))
+			}
+
+			return addresses, nil
+		},
+	}
+
+	s := newTestService(indexer, &fakeDriver{})
+	s.accountQueue = newAccountQueue(2)
+	s.accountConcurrency = 2
+
+	stop := make(chan struct{})
+	s.runAccountWorkers(context.Background(), stop)
+
+	for height := 1; height <= 3; height++ {
+		c.True(s.launchTask(context.Background(), &heightTask{height: height, stages: []string{storage.StageNodes}}))
+	}
+
+	select {
+	case <-waitGroupDone(s.indexingProcesses):
+	case <-time.After(5 * time.Second):
+		c.FailNow("accounts fanned out never drained")
+	}
+
+	close(stop)
+	<-waitGroupDone(s.backgroundProcesses)
+
+	c.Len(indexer.getAccounts(), 60)
+	c.Empty(s.heightsInFlight.incomplete())
+}
@@ -0,0 +1,448 @@
+package main
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"os/signal"
+	"syscall"
+
+	"github.com/pokt-foundation/pocket-indexer-services/config"
+	"github.com/pokt-foundation/pocket-indexer-services/storage"
+	"github.com/urfave/cli/v2"
+)
+
+// Modes the service runs in, one for each subcommand that indexes
+const (
+	modeFollow       = "follow"
+	modeRange        = "range"
+	modeReindex      = "reindex"
+	modeBackfillGaps = "backfill-gaps"
+	modeVerify       = "verify"
+	modeSnapshot     = "snapshot"
+)
+
+var (
+	errMissingHeightRange  = errors.New("from and to heights are required")
+	errInvalidHeight       = errors.New("height must be greater than 0")
+	errBlockHashMismatches = errors.New("block hash mismatches found")
+	errMissingDeadLetter   = errors.New("id of the dead letter or --all is required")
+	errMissingAddresses    = errors.New("addresses to snapshot are required")
+)
+
+// configLoader loads the configuration again, the flags set overriding it
+type configLoader func() (*config.Config, error)
+
+// newApp returns the command line app, its flags override the config file and the env vars
+// The exit code of the command run is set in exitCode
+func newApp(exitCode *int) *cli.App {
+	return &cli.App{
+		Name:  "indexer-service",
+		Usage: "index the pocket blockchain into postgres",
+		Flags: []cli.Flag{
+			&cli.StringFlag{Name: "config", Usage: "path of the YAML config file", Value: config.GetFilePath()},
+			&cli.StringFlag{Name: "connection-string", Usage: "postgres connection string or secret reference"},
+			&cli.StringFlag{Name: "main-node", Usage: "url of the main node"},
+			&cli.StringFlag{Name: "fallback-node", Usage: "url of the fallback node"},
+			&cli.StringSliceFlag{Name: "nodes", Usage: "urls of the nodes added to the pool"},
+			&cli.StringSliceFlag{Name: "stages", Usage: "stages indexed out of block, transactions, nodes, apps and accounts"},
+			&cli.Int64Flag{Name: "concurrency", Usage: "max stages indexed at the same time"},
+			&cli.Int64Flag{Name: "request-interval", Usage: "milliseconds between chain height polls"},
+			&cli.Int64Flag{Name: "client-timeout", Usage: "milliseconds before a node call times out"},
+			&cli.Int64Flag{Name: "client-retries", Usage: "retries of a node call"},
+			&cli.Int64Flag{Name: "service-retries", Usage: "attempts of an indexing call"},
+			&cli.StringFlag{Name: "port", Usage: "port of the metrics and health endpoints"},
+		},
+		// Without a subcommand the mode is taken from the configuration as before the subcommands existed
+		Action: func(c *cli.Context) error {
+			*exitCode = runService("", getConfigLoader(c))
+			return nil
+		},
+		Commands: []*cli.Command{
+			{
+				Name:  modeFollow,
+				Usage: "follow the chain tip, backfilling from the committed heights",
+				Action: func(c *cli.Context) error {
+					*exitCode = runService(modeFollow, getConfigLoader(c))
+					return nil
+				},
+			},
+			{
+				Name:  modeRange,
+				Usage: "index every height between from and to",
+				Flags: getHeightRangeFlags(),
+				Action: func(c *cli.Context) error {
+					*exitCode = runService(modeRange, getConfigLoader(c))
+					return nil
+				},
+			},
+			{
+				Name:  modeReindex,
+				Usage: "delete everything saved at a height and index it again",
+				Flags: []cli.Flag{
+					&cli.IntFlag{Name: "height", Usage: "height to index again", Required: true},
+				},
+				Action: func(c *cli.Context) error {
+					*exitCode = runService(modeReindex, getConfigLoader(c))
+					return nil
+				},
+			},
+			{
+				Name:  modeBackfillGaps,
+				Usage: "index the stages missing up to the max saved height",
+				Action: func(c *cli.Context) error {
+					*exitCode = runService(modeBackfillGaps, getConfigLoader(c))
+					return nil
+				},
+			},
+			{
+				Name:  modeVerify,
+				Usage: "compare the saved block hashes between from and to against the chain",
+				Flags: getHeightRangeFlags(),
+				Action: func(c *cli.Context) error {
+					*exitCode = runService(modeVerify, getConfigLoader(c))
+					return nil
+				},
+			},
+			{
+				Name:  modeSnapshot,
+				Usage: "index the accounts of the addresses every step heights between from and to",
+				Flags: append(getHeightRangeFlags(),
+					&cli.StringSliceFlag{Name: "addresses", Usage: "addresses whose accounts are indexed"},
+					&cli.Int64Flag{Name: "step", Usage: "heights between two snapshots"},
+				),
+				Action: func(c *cli.Context) error {
+					*exitCode = runService(modeSnapshot, getConfigLoader(c))
+					return nil
+				},
+			},
+			{
+				Name:  "status",
+				Usage: "print the committed heights, the chain height and the failed heights",
+				Action: func(c *cli.Context) error {
+					*exitCode = runStatus(getConfigLoader(c))
+					return nil
+				},
+			},
+			getDeadLettersCommand(exitCode),
+		},
+		// Errors are logged by run instead of exiting right away
+		ExitErrHandler: func(c *cli.Context, err error) {},
+	}
+}
+
+func getHeightRangeFlags() []cli.Flag {
+	return []cli.Flag{
+		&cli.IntFlag{Name: "from", Usage: "first height of the range"},
+		&cli.IntFlag{Name: "to", Usage: "last height of the range"},
+	}
+}
+
+// getConfigLoader returns the loader of the config file set in the command,
+// the flags set in the command or its parents override the loaded configuration
+func getConfigLoader(c *cli.Context) configLoader {
+	return func() (*config.Config, error) {
+		loadedConfig, err := config.Load(c.String("config"))
+		if err != nil {
+			return nil, err
+		}
+
+		applyFlags(c, loadedConfig)
+
+		err = loadedConfig.ResolveSecrets()
+		if err != nil {
+			return nil, err
+		}
+
+		return loadedConfig, nil
+	}
+}
+
+func applyFlags(c *cli.Context, loadedConfig *config.Config) {
+	stringFlags := map[string]*string{
+		"connection-string": &loadedConfig.ConnectionString,
+		"main-node":         &loadedConfig.Service.MainNode,
+		"fallback-node":     &loadedConfig.Service.FallbackNode,
+		"port":              &loadedConfig.Service.Port,
+	}
+	int64Flags := map[string]*int64{
+		"concurrency":      &loadedConfig.Service.Concurrency,
+		"request-interval": &loadedConfig.Service.RequestInterval,
+		"client-timeout":   &loadedConfig.Service.ClientTimeout,
+		"client-retries":   &loadedConfig.Service.ClientRetries,
+		"service-retries":  &loadedConfig.Service.ServiceRetries,
+		"step":             &loadedConfig.Service.SnapshotStep,
+	}
+
+	for name, value := range stringFlags {
+		if c.IsSet(name) {
+			*value = c.String(name)
+		}
+	}
+
+	for name, value := range int64Flags {
+		if c.IsSet(name) {
+			*value = c.Int64(name)
+		}
+	}
+
+	sliceFlags := map[string]*[]string{
+		"nodes":     &loadedConfig.Service.Nodes,
+		"stages":    &loadedConfig.Service.Stages,
+		"addresses": &loadedConfig.Service.SnapshotAddresses,
+	}
+
+	for name, value := range sliceFlags {
+		if c.IsSet(name) {
+			*value = c.StringSlice(name)
+		}
+	}
+
+	applyHeightFlags(c, &loadedConfig.Service)
+}
+
+func applyHeightFlags(c *cli.Context, serviceConfig *config.Service) {
+	if c.IsSet("from") {
+		serviceConfig.FromHeight = c.Int("from")
+	}
+
+	if c.IsSet("to") {
+		serviceConfig.ToHeight = c.Int("to")
+	}
+
+	// A reindexed height is a range of a single height
+	if c.IsSet("height") {
+		serviceConfig.FromHeight = c.Int("height")
+		serviceConfig.ToHeight = c.Int("height")
+	}
+}
+
+// getConfigMode returns the mode set by the backfill gaps and from and to heights of the configuration
+func getConfigMode(serviceConfig *config.Service) string {
+	switch {
+	case serviceConfig.BackfillGaps:
+		return modeBackfillGaps
+	case serviceConfig.FromHeight > 0 && serviceConfig.ToHeight > 0:
+		return modeRange
+	default:
+		return modeFollow
+	}
+}
+
+// validateConfig checks the configuration of the mode before anything connects to the database
+func validateConfig(serviceConfig *config.Config, mode string) error {
+	err := serviceConfig.ValidateService()
+	if err != nil {
+		return err
+	}
+
+	fromHeight, toHeight := serviceConfig.Service.FromHeight, serviceConfig.Service.ToHeight
+
+	switch mode {
+	case modeRange, modeVerify:
+		return validateHeightRange(fromHeight, toHeight)
+	case modeSnapshot:
+		if len(serviceConfig.Service.SnapshotAddresses) == 0 {
+			return errMissingAddresses
+		}
+
+		return validateHeightRange(fromHeight, toHeight)
+	case modeReindex:
+		if fromHeight < 1 {
+			return errInvalidHeight
+		}
+	}
+
+	return nil
+}
+
+func validateHeightRange(fromHeight, toHeight int) error {
+	if fromHeight < 1 || toHeight < 1 {
+		return errMissingHeightRange
+	}
+
+	if toHeight < fromHeight {
+		return errToHeightLowerThanFromHeight
+	}
+
+	return nil
+}
+
+// loadValidConfig loads the configuration and checks it for the mode, an empty mode is taken from the configuration
+func loadValidConfig(loadConfig configLoader, mode string) (*config.Config, string, error) {
+	serviceConfig, err := loadConfig()
+	if err != nil {
+		return nil, "", err
+	}
+
+	if mode == "" {
+		mode = getConfigMode(&serviceConfig.Service)
+	}
+
+	err = validateConfig(serviceConfig, mode)
+	if err != nil {
+		return nil, "", err
+	}
+
+	return serviceConfig, mode, nil
+}
+
+// runService runs the service in given mode until it finishes or a signal stops it and returns the exit code
+func runService(mode string, loadConfig configLoader) int {
+	serviceConfig, mode, err := loadValidConfig(loadConfig, mode)
+	if err != nil {
+		log.WithError(err).Error(fmt.Sprintf("Invalid configuration with error: %s", err.Error()))
+		return exitCodeFailure
+	}
+
+	ctx, stop := signal.NotifyContext(context.Background(), syscall.SIGINT, syscall.SIGTERM)
+	defer stop()
+
+	service, err := setupService(serviceConfig, mode, mode != modeVerify)
+	if err != nil {
+		log.WithError(err).Error(fmt.Sprintf("Setup service failed with error: %s", err.Error()))
+		return exitCodeFailure
+	}
+
+	if mode == modeVerify {
+		return service.runVerify()
+	}
+
+	service.loadConfig = loadConfig
+
+	httpCtx, stopHTTP := context.WithCancel(context.Background())
+	defer stopHTTP()
+
+	go service.serveHTTP(httpCtx)
+
+	incompleteHeights, err := service.start(ctx)
+	if err != nil && !errors.Is(err, context.Canceled) {
+		service.logErrorWithFields("Start service failed", -1, err)
+		return exitCodeFailure
+	}
+
+	if len(incompleteHeights) > 0 {
+		log.WithField("incomplete_heights", incompleteHeights).Error(fmt.Sprintf("Shutdown left %d heights incomplete", len(incompleteHeights)))
+		return exitCodeIncompleteHeights
+	}
+
+	log.Info("Execution finished successfully")
+
+	return exitCodeSuccess
+}
+
+// runVerify only logs the block hash mismatches between the from and to heights,
+// they are neither recorded nor repaired but left to the reindex command
+func (s *service) runVerify() int {
+	mismatches, err := s.findBlockHashMismatches(s.fromHeight, s.toHeight)
+	if err != nil {
+		s.logErrorWithFields("Verify block hashes failed", -1, err)
+		return exitCodeFailure
+	}
+
+	if len(mismatches) > 0 {
+		var mismatchedHeights []int
+
+		for _, mismatch := range mismatches {
+			mismatchedHeights = append(mismatchedHeights, mismatch.Height)
+		}
+
+		log.WithField("mismatched_heights", mismatchedHeights).Error(errBlockHashMismatches.Error())
+		return exitCodeFailure
+	}
+
+	log.Info(fmt.Sprintf("Block hashes verified from height %d to height %d", s.fromHeight, s.toHeight))
+
+	return exitCodeSuccess
+}
+
+// status is the progress of the indexing printed by the status command
+type status struct {
+	CommittedHeights map[string]int              `json:"committedHeights"`
+	MaxSavedHeight   int                         `json:"maxSavedHeight"`
+	ChainHeight      int                         `json:"chainHeight"`
+	Lag              int                         `json:"lag"`
+	FailedHeights    *storage.FailedHeightsCount `json:"failedHeights"`
+}
+
+// runStatus prints as JSON the status of the indexing and returns the exit code
+func runStatus(loadConfig configLoader) int {
+	service, err := loadCommandService(loadConfig)
+	if err != nil {
+		return exitCodeFailure
+	}
+
+	indexingStatus, err := service.getStatus()
+	if err != nil {
+		service.logErrorWithFields("Get status failed", -1, err)
+		return exitCodeFailure
+	}
+
+	return printJSON(indexingStatus)
+}
+
+// loadCommandService sets up the service for the commands that do not index, logging why it failed,
+// the tables are expected to be created already by the indexing commands
+func loadCommandService(loadConfig configLoader) (*service, error) {
+	serviceConfig, _, err := loadValidConfig(loadConfig, modeFollow)
+	if err != nil {
+		log.WithError(err).Error(fmt.Sprintf("Invalid configuration with error: %s", err.Error()))
+		return nil, err
+	}
+
+	service, err := setupService(serviceConfig, modeFollow, false)
+	if err != nil {
+		log.WithError(err).Error(fmt.Sprintf("Setup service failed with error: %s", err.Error()))
+		return nil, err
+	}
+
+	return service, nil
+}
+
+// printJSON prints the value as indented JSON to stdout and returns the exit code
+func printJSON(value interface{}) int {
+	encoder := json.NewEncoder(os.Stdout)
+	encoder.SetIndent("", "  ")
+
+	err := encoder.Encode(value)
+	if err != nil {
+		return exitCodeFailure
+	}
+
+	return exitCodeSuccess
+}
+
+func (s *service) getStatus() (*status, error) {
+	resume, err := s.getResumePoint()
+	if err != nil {
+		return nil, err
+	}
+
+	failedHeights, err := s.driver.CountFailedHeights()
+	if err != nil {
+		return nil, err
+	}
+
+	return &status{
+		CommittedHeights: resume.committedHeights,
+		MaxSavedHeight:   resume.maxSavedHeight,
+		ChainHeight:      resume.chainHeight,
+		Lag:              resume.chainHeight - resume.maxSavedHeight,
+		FailedHeights:    failedHeights,
+	}, nil
+}
+
+// run runs the command of the args and returns the exit code
+func run(args []string) int {
+	exitCode := exitCodeSuccess
+
+	err := newApp(&exitCode).Run(args)
+	if err != nil {
+		log.WithError(err).Error(fmt.Sprintf("Run command failed with error: %s", err.Error()))
+		return exitCodeFailure
+	}
+
+	return exitCode
+}
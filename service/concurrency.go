@@ -0,0 +1,166 @@
+package main
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"time"
+
+	providerlib "github.com/pokt-foundation/pocket-go/provider"
+	"github.com/pokt-foundation/pocket-indexer-services/config"
+	"github.com/sirupsen/logrus"
+)
+
+const (
+	directionIncrease = "increase"
+	directionDecrease = "decrease"
+)
+
+// concurrencyController adjusts the limit of the semaphore with additive increase and multiplicative decrease:
+// every interval the provider calls stayed under the latency target and error rate the limit grows by a fixed step,
+// when they did not it is cut by a fraction, always between the min and max limits
+type concurrencyController struct {
+	mu             sync.Mutex
+	limit          int64
+	minLimit       int64
+	maxLimit       int64
+	increase       int64
+	decreaseFactor float64
+	latencyTarget  time.Duration
+	maxErrorRate   float64
+	interval       time.Duration
+	calls          int
+	overloads      int
+	totalLatency   time.Duration
+}
+
+func newConcurrencyController(initialLimit int64, options config.AdaptiveConcurrency) *concurrencyController {
+	controller := &concurrencyController{
+		increase:       options.Increase,
+		decreaseFactor: float64(options.DecreasePercent) / 100,
+		latencyTarget:  milliseconds(options.LatencyTarget),
+		maxErrorRate:   float64(options.MaxErrorPercent) / 100,
+		interval:       milliseconds(options.Interval),
+	}
+
+	controller.setBounds(options.MinConcurrency, options.MaxConcurrency)
+	controller.limit = controller.clamp(initialLimit)
+
+	return controller
+}
+
+// getLimit returns the current limit, the initial concurrency moved inside the bounds until the first adjustment
+func (c *concurrencyController) getLimit() int64 {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	return c.limit
+}
+
+// observe records a provider call for the next adjustment
+func (c *concurrencyController) observe(latency time.Duration, err error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	c.calls++
+	c.totalLatency += latency
+
+	if isOverloadError(err) {
+		c.overloads++
+	}
+}
+
+// isOverloadError reports the errors of a node that can't keep up: timeouts, dropped connections and 5xx responses,
+// pocket-go reports 429 responses as any other 4xx so those count as well
+func isOverloadError(err error) bool {
+	return err != nil && (isConnectionError(err) || errors.Is(err, providerlib.Err4xxOnConnection))
+}
+
+// setBounds changes the min and max limits, the limit is moved inside them on the next adjustment
+func (c *concurrencyController) setBounds(minLimit, maxLimit int64) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	c.minLimit = minLimit
+	c.maxLimit = maxLimit
+}
+
+// clamp must be called with the controller locked unless it is not shared yet
+func (c *concurrencyController) clamp(limit int64) int64 {
+	if limit < c.minLimit {
+		return c.minLimit
+	}
+
+	if limit > c.maxLimit {
+		return c.maxLimit
+	}
+
+	return limit
+}
+
+// run adjusts the limit of the semaphore every interval until ctx is cancelled
+func (c *concurrencyController) run(ctx context.Context, semaphore *limiter) {
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-time.After(c.interval):
+		}
+
+		c.adjust(semaphore)
+	}
+}
+
+// adjust cuts the limit if the calls since the last adjustment overloaded the nodes,
+// otherwise it grows it only when the semaphore was the bottleneck, so an idle service does not reach the max limit
+func (c *concurrencyController) adjust(semaphore *limiter) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	calls, overloads := c.calls, c.overloads
+	overloaded := c.isOverloaded()
+	waited := semaphore.takeWaited()
+
+	c.calls, c.overloads, c.totalLatency = 0, 0, 0
+
+	limit := c.limit
+
+	switch {
+	case overloaded:
+		limit = int64(float64(limit) * (1 - c.decreaseFactor))
+	case waited:
+		limit += c.increase
+	}
+
+	limit = c.clamp(limit)
+	if limit == c.limit {
+		return
+	}
+
+	direction := directionIncrease
+	if limit < c.limit {
+		direction = directionDecrease
+	}
+
+	c.limit = limit
+	semaphore.SetLimit(limit)
+	concurrencyAdjustmentsCounter.WithLabelValues(direction).Inc()
+
+	log.WithFields(logrus.Fields{
+		"concurrency": limit,
+		"calls":       calls,
+		"overloads":   overloads,
+	}).Info("Concurrency adjusted")
+}
+
+// isOverloaded must be called with the controller locked
+func (c *concurrencyController) isOverloaded() bool {
+	if c.calls == 0 {
+		return false
+	}
+
+	errorRate := float64(c.overloads) / float64(c.calls)
+	averageLatency := c.totalLatency / time.Duration(c.calls)
+
+	return errorRate > c.maxErrorRate || averageLatency > c.latencyTarget
+}
@@ -0,0 +1,109 @@
+package main
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	providerlib "github.com/pokt-foundation/pocket-go/provider"
+	"github.com/pokt-foundation/pocket-indexer-services/config"
+	"github.com/stretchr/testify/require"
+)
+
+var testAdaptiveConcurrency = config.AdaptiveConcurrency{
+	Enabled:         true,
+	MinConcurrency:  2,
+	MaxConcurrency:  20,
+	Increase:        2,
+	DecreasePercent: 50,
+	LatencyTarget:   1000,
+	MaxErrorPercent: 10,
+	Interval:        1000,
+}
+
+func TestNewConcurrencyController(t *testing.T) {
+	c := require.New(t)
+
+	c.Equal(int64(2), newConcurrencyController(1, testAdaptiveConcurrency).getLimit())
+	c.Equal(int64(10), newConcurrencyController(10, testAdaptiveConcurrency).getLimit())
+	c.Equal(int64(20), newConcurrencyController(100, testAdaptiveConcurrency).getLimit())
+}
+
+func TestConcurrencyController_Adjust(t *testing.T) {
+	tests := []struct {
+		name      string
+		limit     int64
+		latencies []time.Duration
+		errs      []error
+		waited    bool
+		expected  int64
+	}{
+		{name: "idle semaphore", limit: 10, latencies: []time.Duration{time.Millisecond}, errs: []error{nil}, expected: 10},
+		{name: "semaphore bottleneck", limit: 10, latencies: []time.Duration{time.Millisecond}, errs: []error{nil}, waited: true, expected: 12},
+		{name: "increase capped by the max", limit: 19, waited: true, expected: 20},
+		{name: "no calls", limit: 10, expected: 10},
+		{
+			name:      "slow calls",
+			limit:     10,
+			latencies: []time.Duration{3 * time.Second, time.Millisecond},
+			errs:      []error{nil, nil},
+			waited:    true,
+			expected:  5,
+		},
+		{
+			name:      "overload errors",
+			limit:     10,
+			latencies: []time.Duration{time.Millisecond, time.Millisecond},
+			errs:      []error{providerlib.Err4xxOnConnection, nil},
+			expected:  5,
+		},
+		{
+			name:      "errors not caused by overload",
+			limit:     10,
+			latencies: []time.Duration{time.Millisecond, time.Millisecond},
+			errs:      []error{errors.New("dummy error"), &providerlib.RPCError{Code: 400}},
+			expected:  10,
+		},
+		{
+			name:      "decrease capped by the min",
+			limit:     3,
+			latencies: []time.Duration{time.Millisecond},
+			errs:      []error{providerlib.Err5xxOnConnection},
+			expected:  2,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			c := require.New(t)
+
+			controller := newConcurrencyController(tt.limit, testAdaptiveConcurrency)
+			semaphore := newLimiter(tt.limit)
+			semaphore.waited = tt.waited
+
+			for i, latency := range tt.latencies {
+				controller.observe(latency, tt.errs[i])
+			}
+
+			controller.adjust(semaphore)
+
+			c.Equal(tt.expected, controller.getLimit())
+			c.Equal(tt.expected, semaphore.limit)
+			c.False(semaphore.takeWaited())
+			c.Zero(controller.calls)
+		})
+	}
+}
+
+func TestConcurrencyController_SetBounds(t *testing.T) {
+	c := require.New(t)
+
+	controller := newConcurrencyController(10, testAdaptiveConcurrency)
+	controller.setBounds(12, 15)
+
+	semaphore := newLimiter(10)
+	controller.adjust(semaphore)
+
+	c.Equal(int64(12), controller.getLimit())
+	c.Equal(int64(12), semaphore.limit)
+}
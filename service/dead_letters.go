@@ -0,0 +1,231 @@
+package main
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"os"
+	"os/signal"
+	"syscall"
+
+	"github.com/pokt-foundation/pocket-indexer-services/storage"
+	"github.com/urfave/cli/v2"
+)
+
+const deadLetterFileMode = 0o644
+
+// writeDeadLetter records the failed height that ran out of attempts in the dead letters table
+// and, when it is set, appends it to the dead letter file
+func (s *service) writeDeadLetter(failedHeight *storage.FailedHeight, node string) {
+	deadLetter := &storage.DeadLetter{
+		Height:      failedHeight.Height,
+		Stage:       failedHeight.Stage,
+		Address:     failedHeight.Address,
+		AccountType: failedHeight.AccountType,
+		Node:        node,
+		LastError:   failedHeight.LastError,
+		Attempts:    failedHeight.Attempts,
+		CreatedAt:   failedHeight.UpdatedAt,
+		UpdatedAt:   failedHeight.UpdatedAt,
+	}
+
+	deadLettersCounter.WithLabelValues(deadLetter.Stage).Inc()
+
+	err := s.driver.WriteDeadLetter(deadLetter)
+	if err != nil {
+		s.logErrorWithFields("Write dead letter failed", deadLetter.Height, err)
+	}
+
+	if s.deadLetterFile == "" {
+		return
+	}
+
+	err = appendDeadLetter(s.deadLetterFile, deadLetter)
+	if err != nil {
+		s.logErrorWithFields("Append dead letter to file failed", deadLetter.Height, err)
+	}
+}
+
+// appendDeadLetter appends the dead letter as a JSON line to the file, creating it if it does not exist
+func appendDeadLetter(path string, deadLetter *storage.DeadLetter) error {
+	line, err := json.Marshal(deadLetter)
+	if err != nil {
+		return err
+	}
+
+	file, err := os.OpenFile(path, os.O_APPEND|os.O_CREATE|os.O_WRONLY, deadLetterFileMode)
+	if err != nil {
+		return err
+	}
+
+	_, err = file.Write(append(line, '\n'))
+	if err != nil {
+		_ = file.Close()
+		return err
+	}
+
+	return file.Close()
+}
+
+// getDeadLettersCommand returns the command to list, inspect and replay the dead letters
+func getDeadLettersCommand(exitCode *int) *cli.Command {
+	return &cli.Command{
+		Name:  "dead-letters",
+		Usage: "list, inspect and replay the failed heights that ran out of attempts",
+		Subcommands: []*cli.Command{
+			{
+				Name:  "list",
+				Usage: "print the dead letters, lower heights first",
+				Flags: []cli.Flag{
+					&cli.IntFlag{Name: "limit", Usage: "max dead letters printed", Value: 100},
+				},
+				Action: func(c *cli.Context) error {
+					*exitCode = runListDeadLetters(getConfigLoader(c), c.Int("limit"))
+					return nil
+				},
+			},
+			{
+				Name:  "inspect",
+				Usage: "print a dead letter",
+				Flags: []cli.Flag{
+					&cli.IntFlag{Name: "id", Usage: "id of the dead letter", Required: true},
+				},
+				Action: func(c *cli.Context) error {
+					*exitCode = runInspectDeadLetter(getConfigLoader(c), c.Int("id"))
+					return nil
+				},
+			},
+			{
+				Name:  "replay",
+				Usage: "index a dead letter again, or every one with --all, removing the ones indexed",
+				Flags: []cli.Flag{
+					&cli.IntFlag{Name: "id", Usage: "id of the dead letter"},
+					&cli.BoolFlag{Name: "all", Usage: "replay every dead letter"},
+					&cli.IntFlag{Name: "limit", Usage: "max dead letters replayed with --all", Value: 100},
+				},
+				Action: func(c *cli.Context) error {
+					*exitCode = runReplayDeadLetters(getConfigLoader(c), c.Int("id"), c.Bool("all"), c.Int("limit"))
+					return nil
+				},
+			},
+		},
+	}
+}
+
+func runListDeadLetters(loadConfig configLoader, limit int) int {
+	service, err := loadCommandService(loadConfig)
+	if err != nil {
+		return exitCodeFailure
+	}
+
+	deadLetters, err := service.driver.ReadDeadLetters(limit)
+	if err != nil {
+		service.logErrorWithFields("Read dead letters failed", -1, err)
+		return exitCodeFailure
+	}
+
+	return printJSON(deadLetters)
+}
+
+func runInspectDeadLetter(loadConfig configLoader, id int) int {
+	service, err := loadCommandService(loadConfig)
+	if err != nil {
+		return exitCodeFailure
+	}
+
+	deadLetter, err := service.driver.ReadDeadLetter(id)
+	if err != nil {
+		service.logErrorWithFields("Read dead letter failed", -1, err)
+		return exitCodeFailure
+	}
+
+	return printJSON(deadLetter)
+}
+
+// runReplayDeadLetters indexes the dead letters again, it fails if any of them failed again
+func runReplayDeadLetters(loadConfig configLoader, id int, all bool, limit int) int {
+	if !all && id <= 0 {
+		log.WithError(errMissingDeadLetter).Error(errMissingDeadLetter.Error())
+		return exitCodeFailure
+	}
+
+	service, err := loadCommandService(loadConfig)
+	if err != nil {
+		return exitCodeFailure
+	}
+
+	deadLetters, err := service.getDeadLettersToReplay(id, all, limit)
+	if err != nil {
+		service.logErrorWithFields("Read dead letters failed", -1, err)
+		return exitCodeFailure
+	}
+
+	ctx, stop := signal.NotifyContext(context.Background(), syscall.SIGINT, syscall.SIGTERM)
+	defer stop()
+
+	failed := 0
+
+	for _, deadLetter := range deadLetters {
+		if !service.replayDeadLetter(ctx, deadLetter) {
+			failed++
+		}
+	}
+
+	log.Info(fmt.Sprintf("Replayed %d dead letters, %d failed again", len(deadLetters), failed))
+
+	if failed > 0 {
+		return exitCodeFailure
+	}
+
+	return exitCodeSuccess
+}
+
+func (s *service) getDeadLettersToReplay(id int, all bool, limit int) ([]*storage.DeadLetter, error) {
+	if all {
+		return s.driver.ReadDeadLetters(limit)
+	}
+
+	deadLetter, err := s.driver.ReadDeadLetter(id)
+	if err != nil {
+		return nil, err
+	}
+
+	return []*storage.DeadLetter{deadLetter}, nil
+}
+
+// replayDeadLetter indexes the dead letter through the same calls as the redrive of its stage,
+// removing it once indexed or recording the new failure
+func (s *service) replayDeadLetter(ctx context.Context, deadLetter *storage.DeadLetter) bool {
+	failedHeight := &storage.FailedHeight{
+		Height:      deadLetter.Height,
+		Stage:       deadLetter.Stage,
+		Address:     deadLetter.Address,
+		AccountType: deadLetter.AccountType,
+	}
+
+	err := s.redriveFailedHeight(ctx, failedHeight)
+	if err != nil {
+		s.logErrorWithFields("Replay dead letter failed", deadLetter.Height, err)
+
+		deadLetter.Node = getErrorNode(err)
+		deadLetter.LastError = err.Error()
+		deadLetter.Attempts++
+
+		err = s.driver.WriteDeadLetter(deadLetter)
+		if err != nil {
+			s.logErrorWithFields("Write dead letter failed", deadLetter.Height, err)
+		}
+
+		return false
+	}
+
+	err = s.driver.DeleteDeadLetter(deadLetter)
+	if err != nil {
+		s.logErrorWithFields("Delete dead letter failed", deadLetter.Height, err)
+		return false
+	}
+
+	s.logInfoWithFields("Dead letter replayed successfully", deadLetter.Address, deadLetter.Height)
+
+	return true
+}
@@ -0,0 +1,91 @@
+package main
+
+import (
+	"context"
+	"testing"
+
+	"github.com/pokt-foundation/pocket-indexer-services/storage"
+	"github.com/stretchr/testify/require"
+)
+
+func TestService_RecordFailedHeightPoisoned(t *testing.T) {
+	c := require.New(t)
+
+	driver := &fakeDriver{}
+	s := newTestService(&fakeIndexer{}, driver)
+	s.heightsInFlight.track([]string{storage.StageBlock})
+	s.failedHeightOpts.MaxAttempts = 2
+
+	for height := 1; height <= 2; height++ {
+		s.heightsInFlight.queue(height)
+		s.heightsInFlight.launch(height, []string{storage.StageBlock})
+	}
+
+	s.recordFailedHeight(1, storage.StageBlock, "", "", errDummy)
+	s.heightsInFlight.done(1, storage.StageBlock)
+	s.heightsInFlight.done(2, storage.StageBlock)
+
+	// A failure still retried holds the committed height
+	c.Equal(0, s.heightsInFlight.getCommittedHeights()[storage.StageBlock])
+	c.Empty(driver.deadLetters)
+
+	// Once dead-lettered it is left to the replay command
+	s.recordFailedHeight(1, storage.StageBlock, "", "", errDummy)
+
+	c.Equal(2, s.heightsInFlight.getCommittedHeights()[storage.StageBlock])
+	c.Len(driver.deadLetters, 1)
+	c.Equal(2, driver.deadLetters[0].Attempts)
+}
+
+func TestService_LoadFailedHeights(t *testing.T) {
+	c := require.New(t)
+
+	driver := &fakeDriver{
+		failedHeights: []*storage.FailedHeight{
+			{Height: 1, Stage: storage.StageBlock, Poisoned: true},
+			{Height: 2, Stage: storage.StageNodes},
+		},
+	}
+	s := newTestService(&fakeIndexer{}, driver)
+	s.heightsInFlight.track([]string{storage.StageBlock, storage.StageNodes})
+
+	c.NoError(s.loadFailedHeights(1))
+
+	for height := 1; height <= 3; height++ {
+		s.heightsInFlight.queue(height)
+		s.heightsInFlight.launch(height, []string{storage.StageBlock, storage.StageNodes})
+		s.heightsInFlight.done(height, storage.StageBlock)
+		s.heightsInFlight.done(height, storage.StageNodes)
+	}
+
+	// The dead-lettered block does not hold its committed height, the nodes still retried do
+	c.Equal(map[string]int{storage.StageBlock: 3, storage.StageNodes: 1}, s.heightsInFlight.getCommittedHeights())
+}
+
+func TestService_ReplayDeadLetter(t *testing.T) {
+	c := require.New(t)
+
+	indexer := &fakeIndexer{}
+	driver := &fakeDriver{}
+	s := newTestService(indexer, driver)
+
+	deadLetter := &storage.DeadLetter{ID: 1, Height: 10, Stage: storage.StageBlock, Attempts: 5}
+
+	c.True(s.replayDeadLetter(context.Background(), deadLetter))
+	c.Equal([]*storage.DeadLetter{deadLetter}, driver.deletedDeadLetters)
+	c.Empty(driver.deadLetters)
+
+	// A replay failing again keeps the dead letter with one more attempt
+	indexer.indexBlock = func(height int) error {
+		return errDummy
+	}
+
+	deadLetter = &storage.DeadLetter{ID: 2, Height: 11, Stage: storage.StageBlock, Attempts: 5}
+
+	c.False(s.replayDeadLetter(context.Background(), deadLetter))
+	c.Len(driver.deletedDeadLetters, 1)
+	c.Len(driver.deadLetters, 1)
+	c.Equal(6, driver.deadLetters[0].Attempts)
+	c.Equal(errDummy.Error(), driver.deadLetters[0].LastError)
+	c.Empty(driver.getFailedHeights())
+}
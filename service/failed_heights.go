@@ -0,0 +1,170 @@
+package main
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	indexerlib "github.com/pokt-foundation/pocket-indexer-lib"
+	"github.com/pokt-foundation/pocket-indexer-services/storage"
+)
+
+// isNothingToIndex reports whether the error only means the height had no entities of that kind
+func isNothingToIndex(err error) bool {
+	return errors.Is(err, indexerlib.ErrNoTransactionsToIndex) ||
+		errors.Is(err, indexerlib.ErrNoNodesToIndex) ||
+		errors.Is(err, indexerlib.ErrNoAppsToIndex)
+}
+
+// failStage records the failed height of a stage launched by the scheduler, marking it as aborted if it failed on shutdown
+func (s *service) failStage(ctx context.Context, height int, stage, address string, accountType indexerlib.AccountType, err error) {
+	if ctx.Err() != nil {
+		s.heightsInFlight.abort(height)
+	}
+
+	s.recordFailedHeight(height, stage, address, accountType, err)
+}
+
+// recordFailedHeight holds the stage at the height until the failure is re-driven,
+// once it ran out of attempts it is dead-lettered and no longer holds the committed height
+func (s *service) recordFailedHeight(height int, stage, address string, accountType indexerlib.AccountType, err error) {
+	s.heightsInFlight.fail(height, stage, address)
+
+	failedHeight := &storage.FailedHeight{
+		Height:      height,
+		Stage:       stage,
+		Address:     address,
+		AccountType: string(accountType),
+		LastError:   err.Error(),
+	}
+
+	recordedHeight, writeErr := s.driver.WriteFailedHeight(failedHeight, s.failedHeightOpts)
+	if writeErr != nil {
+		s.logErrorWithFields("Record failed height failed", height, writeErr)
+		return
+	}
+
+	if recordedHeight.Poisoned {
+		s.writeDeadLetter(recordedHeight, getErrorNode(err))
+		s.heightsInFlight.recover(height, stage, address)
+	}
+}
+
+// redriveFailedHeights periodically retries the failed heights that are due until ctx is cancelled
+func (s *service) redriveFailedHeights(ctx context.Context) {
+	defer s.backgroundProcesses.Done()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-time.After(s.redriveInterval):
+		}
+
+		s.redriveFailedHeightsPass(ctx)
+	}
+}
+
+// redriveFailedHeightsPass re-drives a batch of the failed heights due, claimed for the max retry interval
+// so a failed height claimed by a replica that stopped is retried by another one
+func (s *service) redriveFailedHeightsPass(ctx context.Context) {
+	failedHeights, err := s.driver.ClaimRetryableFailedHeights(s.redriveBatchSize, s.trackedStages, s.failedHeightOpts.MaxRetryInterval)
+	if err != nil {
+		s.logErrorWithFields("Read failed heights failed", -1, err)
+		return
+	}
+
+	for _, failedHeight := range failedHeights {
+		if ctx.Err() != nil {
+			return
+		}
+
+		s.settleFailedHeight(ctx, failedHeight)
+	}
+}
+
+// settleFailedHeight re-drives the failed height, deleting it on success or recording one more attempt
+func (s *service) settleFailedHeight(ctx context.Context, failedHeight *storage.FailedHeight) {
+	err := s.redriveFailedHeight(ctx, failedHeight)
+	if err != nil {
+		// An attempt interrupted by a shutdown is not counted
+		if ctx.Err() == nil {
+			s.recordFailedHeight(failedHeight.Height, failedHeight.Stage, failedHeight.Address,
+				indexerlib.AccountType(failedHeight.AccountType), err)
+		}
+
+		return
+	}
+
+	err = s.driver.DeleteFailedHeight(failedHeight)
+	if err != nil {
+		s.logErrorWithFields("Delete failed height failed", failedHeight.Height, err)
+		return
+	}
+
+	s.heightsInFlight.recover(failedHeight.Height, failedHeight.Stage, failedHeight.Address)
+
+	s.logInfoWithFields("Failed height re-indexed successfully", failedHeight.Address, failedHeight.Height)
+}
+
+func (s *service) redriveFailedHeight(ctx context.Context, failedHeight *storage.FailedHeight) error {
+	height := failedHeight.Height
+
+	switch failedHeight.Stage {
+	case storage.StageBlock:
+		return s.indexBlockWithFallback(ctx, height)
+	case storage.StageTransactions:
+		return s.redriveAccountsStage(ctx, s.indexBlockTransactionsWithAddresses, height, storage.AccountTypeWallet)
+	case storage.StageNodes:
+		return s.redriveAccountsStage(ctx, s.indexBlockNodesWithFallback, height, indexerlib.AccountTypeNode)
+	case storage.StageApps:
+		return s.redriveAccountsStage(ctx, s.indexBlockAppsWithFallback, height, indexerlib.AccountTypeApp)
+	case storage.StageAccounts:
+		// An accounts stage recorded without address failed before its addresses were read
+		if failedHeight.Address == "" {
+			return s.redriveMissingAccounts(ctx, height)
+		}
+
+		return s.indexAccountWithFallback(ctx, failedHeight.Address, height, indexerlib.AccountType(failedHeight.AccountType))
+	default:
+		return storage.ErrUnknownStage
+	}
+}
+
+// redriveAccountsStage re-indexes a transactions, nodes or apps stage and then its accounts one by one if they are indexed,
+// recording each account that fails on its own
+// A shutdown stops the accounts left and keeps the stage recorded, so its accounts are re-driven again
+// without an attempt counted for the accounts it interrupted
+func (s *service) redriveAccountsStage(ctx context.Context, indexStage func(ctx context.Context, height int) ([]string, error), height int, accountType indexerlib.AccountType) error {
+	addresses, err := indexStage(ctx, height)
+	if err != nil || !containsStage(s.trackedStages, storage.StageAccounts) {
+		return ignoreNothingToIndex(err)
+	}
+
+	return s.redriveAccounts(ctx, addresses, height, accountType)
+}
+
+// redriveAccounts indexes the accounts one by one, recording each account that fails on its own,
+// until ctx is cancelled, whose error is returned
+func (s *service) redriveAccounts(ctx context.Context, addresses []string, height int, accountType indexerlib.AccountType) error {
+	for _, address := range addresses {
+		err := s.indexAccountWithFallback(ctx, address, height, accountType)
+		if ctx.Err() != nil {
+			return ctx.Err()
+		}
+
+		if err != nil {
+			s.recordFailedHeight(height, storage.StageAccounts, address, accountType, err)
+		}
+	}
+
+	return nil
+}
+
+func ignoreNothingToIndex(err error) error {
+	if isNothingToIndex(err) {
+		return nil
+	}
+
+	return err
+}
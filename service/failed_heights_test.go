@@ -0,0 +1,129 @@
+package main
+
+import (
+	"context"
+	"testing"
+
+	indexerlib "github.com/pokt-foundation/pocket-indexer-lib"
+	"github.com/pokt-foundation/pocket-indexer-services/storage"
+	"github.com/stretchr/testify/require"
+)
+
+func TestService_RedriveAccountsStage(t *testing.T) {
+	c := require.New(t)
+
+	indexer := &fakeIndexer{
+		indexAccount: func(address string, height int, accountType indexerlib.AccountType) error {
+			if address == "failing" {
+				return errDummy
+			}
+
+			return nil
+		},
+	}
+	driver := &fakeDriver{}
+	s := newTestService(indexer, driver)
+
+	err := s.redriveAccountsStage(context.Background(), func(ctx context.Context, height int) ([]string, error) {
+		return []string{"failing", "indexed"}, nil
+	}, 10, indexerlib.AccountTypeNode)
+	c.NoError(err)
+
+	// The failing account is indexed again with the fallback indexer
+	c.Len(indexer.getAccounts(), 3)
+
+	failedHeights := driver.getFailedHeights()
+	c.Len(failedHeights, 1)
+	c.Equal("failing", failedHeights[0].Address)
+	c.Equal(storage.StageAccounts, failedHeights[0].Stage)
+}
+
+func TestService_SettleFailedHeightOnShutdown(t *testing.T) {
+	c := require.New(t)
+
+	ctx, cancel := context.WithCancel(context.Background())
+
+	indexer := &fakeIndexer{
+		indexAccount: func(address string, height int, accountType indexerlib.AccountType) error {
+			cancel()
+			return context.Canceled
+		},
+	}
+	driver := &fakeDriver{}
+	s := newTestService(indexer, driver)
+
+	s.settleFailedHeight(ctx, &storage.FailedHeight{Height: 10, Stage: storage.StageAccounts, Address: "interrupted"})
+	c.Empty(driver.getFailedHeights())
+	c.Empty(driver.deletedFailedHeights)
+
+	// The accounts of a re-driven stage interrupted by a shutdown are not counted as attempts
+	err := s.redriveAccountsStage(ctx, func(ctx context.Context, height int) ([]string, error) {
+		return []string{"interrupted", "left"}, nil
+	}, 10, indexerlib.AccountTypeApp)
+	c.ErrorIs(err, context.Canceled)
+	c.Empty(driver.getFailedHeights())
+	c.Len(indexer.getAccounts(), 2)
+}
+
+func TestService_SettleFailedHeight(t *testing.T) {
+	c := require.New(t)
+
+	indexer := &fakeIndexer{}
+	driver := &fakeDriver{}
+	s := newTestService(indexer, driver)
+
+	failedHeight := &storage.FailedHeight{Height: 10, Stage: storage.StageBlock}
+	s.settleFailedHeight(context.Background(), failedHeight)
+
+	c.Equal([]*storage.FailedHeight{failedHeight}, driver.deletedFailedHeights)
+
+	indexer.indexBlock = func(height int) error {
+		return errDummy
+	}
+
+	s.settleFailedHeight(context.Background(), failedHeight)
+
+	failedHeights := driver.getFailedHeights()
+	c.Len(failedHeights, 1)
+	c.Equal(errDummy.Error(), failedHeights[0].LastError)
+}
+
+func TestService_RedriveMissingAccounts(t *testing.T) {
+	c := require.New(t)
+
+	indexer := &fakeIndexer{
+		indexAccount: func(address string, height int, accountType indexerlib.AccountType) error {
+			if address == "failing" {
+				return errDummy
+			}
+
+			return nil
+		},
+	}
+	driver := &fakeDriver{
+		missingAccounts: []*storage.MissingAccount{
+			{Address: "failing", AccountType: string(indexerlib.AccountTypeNode)},
+			{Address: "indexed", AccountType: string(indexerlib.AccountTypeNode)},
+		},
+	}
+	s := newTestService(indexer, driver)
+
+	c.NoError(s.redriveFailedHeight(context.Background(), &storage.FailedHeight{Height: 10, Stage: storage.StageAccounts}))
+
+	failedHeights := driver.getFailedHeights()
+	c.Len(failedHeights, 1)
+	c.Equal("failing", failedHeights[0].Address)
+	c.Equal(string(indexerlib.AccountTypeNode), failedHeights[0].AccountType)
+
+	// A shutdown stops the accounts left without recording the one it interrupted
+	ctx, cancel := context.WithCancel(context.Background())
+
+	indexer.indexAccount = func(address string, height int, accountType indexerlib.AccountType) error {
+		cancel()
+		return context.Canceled
+	}
+
+	err := s.redriveFailedHeight(ctx, &storage.FailedHeight{Height: 10, Stage: storage.StageAccounts})
+	c.ErrorIs(err, context.Canceled)
+	c.Len(driver.getFailedHeights(), 1)
+}
@@ -0,0 +1,149 @@
+package main
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	indexerlib "github.com/pokt-foundation/pocket-indexer-lib"
+	postgresdriver "github.com/pokt-foundation/pocket-indexer-lib/postgres-driver"
+	"github.com/pokt-foundation/pocket-indexer-services/storage"
+)
+
+// produceMissingHeights queues the stages missing in the database between height 1 and the max saved block
+func (s *service) produceMissingHeights(ctx context.Context, tasks chan<- *heightTask) error {
+	maxSavedHeight, err := s.driver.GetMaxHeightInBlocks()
+	if err != nil {
+		// Nothing was saved yet so there can't be any gap
+		if errors.Is(err, postgresdriver.ErrNoPreviousHeight) {
+			return nil
+		}
+
+		return err
+	}
+
+	return s.queueMissingHeights(ctx, tasks, int(maxSavedHeight))
+}
+
+// queueMissingHeights queues the stages missing between height 1 and given height,
+// skipping the heights already queued or running
+func (s *service) queueMissingHeights(ctx context.Context, tasks chan<- *heightTask, toHeight int) error {
+	if toHeight < 1 {
+		return nil
+	}
+
+	missingHeights, err := s.driver.ReadMissingHeights(1, toHeight)
+	if err != nil {
+		return err
+	}
+
+	heightsToIndex, stagesByHeight := groupMissingHeights(missingHeights, s.trackedStages)
+
+	log.Info(fmt.Sprintf("Backfilling %d stages missing in %d heights", len(missingHeights), len(heightsToIndex)))
+
+	for _, height := range heightsToIndex {
+		if s.heightsInFlight.isScheduled(height) {
+			continue
+		}
+
+		if !s.sendTask(ctx, tasks, height, stagesByHeight[height]) {
+			return ctx.Err()
+		}
+	}
+
+	return nil
+}
+
+// sweepGaps queues the stages missing up to the committed height every gap sweep interval
+// Heights above it may still be in flight so they are left for the next sweeps
+func (s *service) sweepGaps(ctx context.Context, tasks chan<- *heightTask) error {
+	if s.gapSweepInterval <= 0 {
+		return nil
+	}
+
+	for {
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-time.After(s.gapSweepInterval):
+		}
+
+		err := s.queueMissingHeights(ctx, tasks, s.heightsInFlight.getCommitted())
+		if err != nil {
+			if errors.Is(err, context.Canceled) {
+				return err
+			}
+
+			s.logErrorWithFields("Sweep missing heights failed", -1, err)
+		}
+	}
+}
+
+// groupMissingHeights groups the missing stages by height, leaving out the stages not tracked
+func groupMissingHeights(missingHeights []*storage.MissingHeight, trackedStages []string) ([]int, map[int][]string) {
+	var heights []int
+	stagesByHeight := make(map[int][]string)
+
+	for _, missingHeight := range missingHeights {
+		if !containsStage(trackedStages, missingHeight.Stage) {
+			continue
+		}
+
+		if _, ok := stagesByHeight[missingHeight.Height]; !ok {
+			heights = append(heights, missingHeight.Height)
+		}
+
+		stagesByHeight[missingHeight.Height] = append(stagesByHeight[missingHeight.Height], missingHeight.Stage)
+	}
+
+	return heights, stagesByHeight
+}
+
+// indexMissingAccounts indexes the accounts of the transactions, nodes and apps saved at the height that have no account saved
+func (s *service) indexMissingAccounts(ctx context.Context, height int) {
+	defer s.releaseProcess(height, storage.StageAccounts)
+
+	missingAccounts, err := s.driver.ReadMissingAccounts(height)
+	if err != nil {
+		s.logErrorWithFields("Read missing accounts failed", height, err)
+		s.failStage(ctx, height, storage.StageAccounts, "", "", err)
+		return
+	}
+
+	for accountType, addresses := range groupMissingAccounts(missingAccounts) {
+		s.indexAccounts(ctx, addresses, height, accountType)
+	}
+
+	s.logInfoWithFields("Missing accounts indexed successfully", "", height)
+}
+
+// redriveMissingAccounts re-drives an accounts stage that failed before its addresses were known,
+// indexing the accounts missing at the height one by one and recording each account that fails on its own
+// A shutdown keeps the stage recorded, so the accounts left are re-driven again
+func (s *service) redriveMissingAccounts(ctx context.Context, height int) error {
+	missingAccounts, err := s.driver.ReadMissingAccounts(height)
+	if err != nil {
+		return err
+	}
+
+	for accountType, addresses := range groupMissingAccounts(missingAccounts) {
+		err = s.redriveAccounts(ctx, addresses, height, accountType)
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func groupMissingAccounts(missingAccounts []*storage.MissingAccount) map[indexerlib.AccountType][]string {
+	addressesByType := make(map[indexerlib.AccountType][]string)
+
+	for _, missingAccount := range missingAccounts {
+		accountType := indexerlib.AccountType(missingAccount.AccountType)
+		addressesByType[accountType] = append(addressesByType[accountType], missingAccount.Address)
+	}
+
+	return addressesByType
+}
@@ -0,0 +1,107 @@
+package main
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"sync/atomic"
+	"time"
+
+	postgresdriver "github.com/pokt-foundation/pocket-indexer-lib/postgres-driver"
+)
+
+var (
+	errNoProviderAnswered = errors.New("no provider answered the block height")
+
+	// lastHeartbeat is the unix nano time the main loop or an indexing process last made progress
+	lastHeartbeat int64
+)
+
+// readiness struct handler for the readiness check response
+type readiness struct {
+	Ready    bool   `json:"ready"`
+	Database string `json:"database"`
+	Provider string `json:"provider"`
+	Lag      int    `json:"lag"`
+}
+
+func heartbeat() {
+	atomic.StoreInt64(&lastHeartbeat, time.Now().UnixNano())
+}
+
+func (s *service) isAlive() bool {
+	return time.Since(time.Unix(0, atomic.LoadInt64(&lastHeartbeat))) < s.livenessTimeout
+}
+
+func writeJSON(w http.ResponseWriter, statusCode int, body any) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(statusCode)
+
+	err := json.NewEncoder(w).Encode(body)
+	if err != nil {
+		log.WithError(err).Error("Write HTTP response failed")
+	}
+}
+
+// healthz answers if the process is alive and its main loop kept ticking
+func (s *service) healthz() http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		if !s.isAlive() {
+			writeJSON(w, http.StatusServiceUnavailable, map[string]bool{"alive": false})
+			return
+		}
+
+		writeJSON(w, http.StatusOK, map[string]bool{"alive": true})
+	}
+}
+
+// readyz answers if the database and a provider are reachable and the indexing lag is under the threshold
+func (s *service) readyz() http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		readiness := s.getReadiness(r.Context())
+		if !readiness.Ready {
+			writeJSON(w, http.StatusServiceUnavailable, readiness)
+			return
+		}
+
+		writeJSON(w, http.StatusOK, readiness)
+	}
+}
+
+func (s *service) getReadiness(ctx context.Context) *readiness {
+	readiness := &readiness{
+		Database: "ok",
+		Provider: "ok",
+	}
+
+	maxSavedHeight, err := s.driver.GetMaxHeightInBlocks()
+	if err != nil && !errors.Is(err, postgresdriver.ErrNoPreviousHeight) {
+		readiness.Database = err.Error()
+	}
+
+	currentHeight, err := s.getBlockHeightFromAnyProvider(ctx)
+	if err != nil {
+		readiness.Provider = err.Error()
+	}
+
+	readiness.Lag = currentHeight - int(maxSavedHeight)
+	readiness.Ready = readiness.Database == "ok" && readiness.Provider == "ok" && readiness.Lag <= s.readinessMaxLag
+
+	return readiness
+}
+
+// getBlockHeightFromAnyProvider asks the pool for the block height, the calls are given up once ctx is cancelled
+func (s *service) getBlockHeightFromAnyProvider(ctx context.Context) (int, error) {
+	currentHeight, err := s.providerPool.view(ctx, false).GetBlockHeight()
+	if err == nil {
+		return currentHeight, nil
+	}
+
+	currentHeight, err = s.providerPool.view(ctx, true).GetBlockHeight()
+	if err != nil {
+		return 0, errNoProviderAnswered
+	}
+
+	return currentHeight, nil
+}
@@ -0,0 +1,50 @@
+package main
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus/promhttp"
+)
+
+const httpShutdownTimeout = 5 * time.Second
+
+func (s *service) newHTTPServer() *http.Server {
+	mux := http.NewServeMux()
+
+	mux.Handle("/metrics", promhttp.Handler())
+	mux.Handle("/healthz", s.healthz())
+	mux.Handle("/readyz", s.readyz())
+
+	return &http.Server{
+		Addr:              ":" + s.port,
+		Handler:           mux,
+		ReadHeaderTimeout: httpShutdownTimeout,
+	}
+}
+
+// serveHTTP serves the service HTTP endpoints until ctx is cancelled
+func (s *service) serveHTTP(ctx context.Context) {
+	server := s.newHTTPServer()
+
+	go func() {
+		<-ctx.Done()
+
+		shutdownCtx, cancel := context.WithTimeout(context.Background(), httpShutdownTimeout)
+		defer cancel()
+
+		err := server.Shutdown(shutdownCtx)
+		if err != nil {
+			s.logErrorWithFields("Shutdown HTTP server failed", -1, err)
+		}
+	}()
+
+	log.Info("Service HTTP server running in port:" + s.port)
+
+	err := server.ListenAndServe()
+	if err != nil && !errors.Is(err, http.ErrServerClosed) {
+		s.logErrorWithFields("Serve HTTP failed", -1, err)
+	}
+}
@@ -0,0 +1,149 @@
+package main
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/pokt-foundation/pocket-indexer-services/config"
+	"github.com/pokt-foundation/pocket-indexer-services/storage"
+)
+
+// leaderLockName is the name of the advisory lock held by the replica following the tip
+const leaderLockName = "pocket-indexer-tip-follower"
+
+var errLeadershipLost = errors.New("leadership lost")
+
+// leaderElection is the configuration of the election of the replica following the tip, nil when it is disabled
+type leaderElection struct {
+	retryInterval time.Duration
+	checkInterval time.Duration
+}
+
+func newLeaderElection(options config.LeaderElection) *leaderElection {
+	if !options.Enabled {
+		return nil
+	}
+
+	return &leaderElection{
+		retryInterval: milliseconds(options.RetryInterval),
+		checkInterval: milliseconds(options.CheckInterval),
+	}
+}
+
+// leadership is the leader lock held while following the tip, lost is set once the lock was lost
+// and only read after the watch of the lock returned
+type leadership struct {
+	lock *storage.LeaderLock
+	lost bool
+}
+
+// waitLeadership waits as a standby until the leader lock is taken, trying it every retry interval,
+// nil is returned when following without leader election
+func (s *service) waitLeadership(ctx context.Context) (*leadership, error) {
+	if s.election == nil || !s.isFollowing() {
+		return nil, nil
+	}
+
+	for {
+		// A standby is alive while it waits
+		heartbeat()
+
+		lock, err := s.driver.TryLeaderLock(ctx, leaderLockName)
+		if err == nil {
+			leaderGauge.Set(1)
+			log.Info("Leadership taken, following the tip")
+
+			return &leadership{lock: lock}, nil
+		}
+
+		if !errors.Is(err, storage.ErrLeaderLockHeld) && ctx.Err() == nil {
+			s.logErrorWithFields("Take leadership failed", -1, err)
+		}
+
+		select {
+		case <-ctx.Done():
+			return nil, ctx.Err()
+		case <-time.After(s.election.retryInterval):
+		}
+	}
+}
+
+// watchLeadership checks the leader lock every check interval, once it was lost the indexing is stopped
+// through cancelLeadership and the drain expires so the heights in flight are not waited for
+// while the new leader indexes them, only the provider and database calls already running still finish
+func (s *service) watchLeadership(ctx context.Context, leader *leadership, cancelLeadership context.CancelFunc, drain *drain) {
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-time.After(s.election.checkInterval):
+		}
+
+		err := leader.lock.Check(ctx)
+		if err == nil || ctx.Err() != nil {
+			continue
+		}
+
+		s.logErrorWithFields("Leadership lost", -1, err)
+		leaderGauge.Set(0)
+
+		leader.lost = true
+		cancelLeadership()
+		drain.expire()
+
+		return
+	}
+}
+
+// stepDown releases the leader lock once the indexing stopped,
+// returning errLeadershipLost instead of the error of the indexing when the lock was lost
+func (s *service) stepDown(leader *leadership, err error) error {
+	leaderGauge.Set(0)
+
+	releaseErr := leader.lock.Release()
+	if releaseErr != nil && !leader.lost {
+		s.logErrorWithFields("Release leadership failed", -1, releaseErr)
+	}
+
+	if leader.lost {
+		return errLeadershipLost
+	}
+
+	return err
+}
+
+// standBy waits up to the shutdown timeout for the processes of the lost leadership, which were cancelled with it,
+// keeping the replica alive meanwhile, the next leadership starts a new term and resumes from the committed heights
+// saved by the leaders in between, the processes still running are left with the heights of their own term
+func (s *service) standBy() {
+	log.Info("Leadership lost, waiting as a standby")
+
+	stopped := make(chan struct{})
+
+	go func() {
+		defer close(stopped)
+
+		s.indexingProcesses.Wait()
+		s.backgroundProcesses.Wait()
+	}()
+
+	timeout := time.NewTimer(s.shutdownTimeout)
+	defer timeout.Stop()
+
+	ticker := time.NewTicker(s.election.retryInterval)
+	defer ticker.Stop()
+
+	for {
+		heartbeat()
+
+		select {
+		case <-stopped:
+			return
+		case <-timeout.C:
+			log.Error("Shutdown timeout expired before the processes of the lost leadership finished")
+			return
+		case <-ticker.C:
+		}
+	}
+}
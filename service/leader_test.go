@@ -0,0 +1,134 @@
+package main
+
+import (
+	"context"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/pokt-foundation/pocket-indexer-services/storage"
+	"github.com/stretchr/testify/require"
+)
+
+// newLeaderLock takes the leader lock on a mocked connection, the expectations of the lock checks are set on the mock
+func newLeaderLock(c *require.Assertions) (*storage.LeaderLock, sqlmock.Sqlmock) {
+	db, mock, err := sqlmock.New()
+	c.NoError(err)
+
+	mock.ExpectQuery("pg_try_advisory_lock").WithArgs(leaderLockName).
+		WillReturnRows(sqlmock.NewRows([]string{"pg_try_advisory_lock"}).AddRow(true))
+
+	lock, err := storage.NewPostgresDriverFromSQLDBInstance(db).TryLeaderLock(context.Background(), leaderLockName)
+	c.NoError(err)
+
+	return lock, mock
+}
+
+func TestService_WatchLeadershipLost(t *testing.T) {
+	c := require.New(t)
+
+	lock, mock := newLeaderLock(c)
+
+	mock.ExpectQuery("pg_locks").WithArgs(leaderLockName).
+		WillReturnRows(sqlmock.NewRows([]string{"exists"}).AddRow(true))
+	mock.ExpectQuery("pg_locks").WithArgs(leaderLockName).
+		WillReturnRows(sqlmock.NewRows([]string{"exists"}).AddRow(false))
+	mock.ExpectExec("pg_advisory_unlock").WithArgs(leaderLockName).
+		WillReturnResult(sqlmock.NewResult(0, 0))
+
+	s := newTestService(&fakeIndexer{}, &fakeDriver{})
+	s.election = &leaderElection{retryInterval: time.Millisecond, checkInterval: time.Millisecond}
+	s.drain = newDrain()
+
+	leader := &leadership{lock: lock}
+	leaderCtx, cancelLeadership := context.WithCancel(context.Background())
+	defer cancelLeadership()
+
+	s.watchLeadership(leaderCtx, leader, cancelLeadership, s.drain)
+
+	// The indexing is stopped right away without waiting for the heights in flight
+	c.True(leader.lost)
+	c.Error(leaderCtx.Err())
+
+	select {
+	case <-s.drain.expired:
+	default:
+		c.Fail("drain not expired on the leadership lost")
+	}
+
+	c.Equal(errLeadershipLost, s.stepDown(leader, context.Canceled))
+	c.NoError(mock.ExpectationsWereMet())
+}
+
+func TestService_StepDown(t *testing.T) {
+	c := require.New(t)
+
+	lock, mock := newLeaderLock(c)
+
+	mock.ExpectExec("pg_advisory_unlock").WithArgs(leaderLockName).
+		WillReturnResult(sqlmock.NewResult(0, 0))
+
+	s := newTestService(&fakeIndexer{}, &fakeDriver{})
+
+	// A leader stopped by a shutdown returns the error of the indexing
+	c.Equal(context.Canceled, s.stepDown(&leadership{lock: lock}, context.Canceled))
+	c.NoError(mock.ExpectationsWereMet())
+}
+
+func TestService_StandBy(t *testing.T) {
+	c := require.New(t)
+
+	s := newTestService(&fakeIndexer{}, &fakeDriver{})
+	s.election = &leaderElection{retryInterval: 5 * time.Millisecond, checkInterval: time.Millisecond}
+	s.shutdownTimeout = 50 * time.Millisecond
+
+	// A process of the lost leadership that never finishes only holds the standby up to the shutdown timeout
+	s.indexingProcesses.Add(1)
+	defer s.indexingProcesses.Done()
+
+	atomic.StoreInt64(&lastHeartbeat, 0)
+
+	start := time.Now()
+	s.standBy()
+
+	c.GreaterOrEqual(time.Since(start), 50*time.Millisecond)
+	c.Less(time.Since(start), time.Second)
+
+	// The replica kept beating while it waited
+	c.Greater(atomic.LoadInt64(&lastHeartbeat), start.UnixNano())
+
+	// Without processes left it returns right away
+	term := s.newTerm()
+	start = time.Now()
+	term.standBy()
+
+	c.Less(time.Since(start), 50*time.Millisecond)
+}
+
+func TestService_NewTerm(t *testing.T) {
+	c := require.New(t)
+
+	reqInterval := int64(time.Second)
+
+	s := newTestService(&fakeIndexer{}, &fakeDriver{})
+	s.reqInterval = &reqInterval
+
+	term := s.newTerm()
+	next := s.newTerm()
+
+	// The terms share nothing in flight, the processes of a lost leadership never touch the next one
+	c.NotSame(term.heightsInFlight, next.heightsInFlight)
+	c.NotSame(term.indexingProcesses, next.indexingProcesses)
+	c.NotSame(term.backgroundProcesses, next.backgroundProcesses)
+	c.NotSame(term.accountQueue, next.accountQueue)
+	c.NotSame(term.drain, next.drain)
+	c.Equal(cap(s.accountQueue.tasks), cap(term.accountQueue.tasks))
+
+	term.heightsInFlight.queue(10)
+	c.False(next.heightsInFlight.isScheduled(10))
+
+	// A reloaded request interval is kept by the next terms
+	atomic.StoreInt64(term.reqInterval, int64(time.Minute))
+	c.Equal(time.Minute, next.getRequestInterval())
+}
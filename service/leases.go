@@ -0,0 +1,237 @@
+package main
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"os"
+	"time"
+
+	"github.com/pokt-foundation/pocket-indexer-services/config"
+	"github.com/pokt-foundation/pocket-indexer-services/storage"
+	"github.com/sirupsen/logrus"
+)
+
+// leasing is the configuration of the height ranges leased while following, nil when leasing is disabled
+type leasing struct {
+	owner             string
+	rangeSize         int
+	duration          time.Duration
+	heartbeatInterval time.Duration
+}
+
+func newLeasing(options config.Leases) (*leasing, error) {
+	if !options.Enabled {
+		return nil, nil
+	}
+
+	owner := options.Owner
+	if owner == "" {
+		hostname, err := os.Hostname()
+		if err != nil {
+			return nil, err
+		}
+
+		owner = fmt.Sprintf("%s-%d", hostname, os.Getpid())
+	}
+
+	return &leasing{
+		owner:             owner,
+		rangeSize:         int(options.RangeSize),
+		duration:          milliseconds(options.Duration),
+		heartbeatInterval: milliseconds(options.HeartbeatInterval),
+	}, nil
+}
+
+// isCheckpointing reports whether the committed heights are saved, the replicas leasing heights
+// index ranges apart from each other so the leases completed keep their progress instead
+func (s *service) isCheckpointing() bool {
+	return s.isFollowing() && s.leasing == nil
+}
+
+// produceLeasedHeights queues the heights of the ranges leased to this replica, claiming the next range once
+// the previous one was queued and waiting a request interval when every height up to the chain height is leased
+// New ranges start after the lowest committed height, the heights indexed before leasing was enabled are not leased
+func (s *service) produceLeasedHeights(ctx context.Context, tasks chan<- *heightTask) error {
+	resume, err := s.getResumePoint()
+	if err != nil {
+		return err
+	}
+
+	firstHeight := getMinCommitted(resume.committedHeights) + 1
+
+	log.WithField("owner", s.leasing.owner).Info(fmt.Sprintf("Leasing height ranges from height %d", firstHeight))
+
+	for {
+		heartbeat()
+
+		lease, err := s.claimHeightLease(firstHeight)
+		if err == nil {
+			s.queueHeightLease(ctx, tasks, lease)
+			continue
+		}
+
+		if !errors.Is(err, storage.ErrNoHeightLease) {
+			s.logErrorWithFields("Claim height lease failed", -1, err)
+		}
+
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-time.After(s.getRequestInterval()):
+		}
+	}
+}
+
+func (s *service) claimHeightLease(firstHeight int) (*storage.HeightLease, error) {
+	chainHeight, err := s.readChainHeight()
+	if err != nil {
+		return nil, err
+	}
+
+	return s.driver.ClaimHeightLease(&storage.ClaimHeightLeaseOptions{
+		Owner:       s.leasing.owner,
+		FirstHeight: firstHeight,
+		MaxHeight:   chainHeight,
+		Size:        s.leasing.rangeSize,
+		Duration:    s.leasing.duration,
+	})
+}
+
+// queueHeightLease queues the heights of the lease while it is held in the background,
+// a lease whose stages could not be read is released so another replica reclaims it
+// The heights are indexed with a context of the lease, cancelled once it is lost to another owner
+func (s *service) queueHeightLease(ctx context.Context, tasks chan<- *heightTask, lease *storage.HeightLease) {
+	resume, err := s.getLeaseResumePoint(lease)
+	if err != nil {
+		s.logErrorWithFields("Read height lease missing stages failed", lease.FromHeight, err)
+		s.releaseHeightLease(lease)
+
+		return
+	}
+
+	s.logLease("Height lease claimed", lease)
+	leasesClaimedCounter.Inc()
+
+	queued := make(chan bool, 1)
+	leaseCtx, cancelLease := context.WithCancel(ctx)
+
+	s.backgroundProcesses.Add(1)
+
+	go s.holdHeightLease(lease, queued, cancelLease)
+
+	for height := lease.FromHeight; height <= lease.ToHeight; height++ {
+		stages := resume.getStagesToQueue(height, resume.stagesByHeight[height])
+		if len(stages) == 0 {
+			continue
+		}
+
+		if !s.queueTask(leaseCtx, tasks, &heightTask{height: height, stages: stages, ctx: leaseCtx}) {
+			queued <- false
+			return
+		}
+	}
+
+	queued <- true
+}
+
+// leaseResumePoint is where a lease is indexed from, the stages missing at each of its heights
+type leaseResumePoint struct {
+	resumePoint
+	stagesByHeight map[int][]string
+}
+
+// getLeaseResumePoint returns the stages to launch at each height of the lease, every stage for a new lease
+// and only the missing ones for a reclaimed lease, which its previous owner may have partially indexed
+func (s *service) getLeaseResumePoint(lease *storage.HeightLease) (*leaseResumePoint, error) {
+	resume := &leaseResumePoint{
+		resumePoint: resumePoint{
+			committedHeights: make(map[string]int, len(s.trackedStages)),
+			maxSavedHeight:   lease.FromHeight - 1,
+		},
+		stagesByHeight: make(map[int][]string),
+	}
+
+	for _, stage := range s.trackedStages {
+		resume.committedHeights[stage] = lease.FromHeight - 1
+	}
+
+	if lease.Claims <= 1 {
+		return resume, nil
+	}
+
+	missingHeights, err := s.driver.ReadMissingHeights(lease.FromHeight, lease.ToHeight)
+	if err != nil {
+		return nil, err
+	}
+
+	resume.maxSavedHeight = lease.ToHeight
+	_, resume.stagesByHeight = groupMissingHeights(missingHeights, s.trackedStages)
+
+	return resume, nil
+}
+
+// holdHeightLease renews the lease every heartbeat interval until its heights are queued and no longer in flight,
+// then completes it or, when some were not indexed because of a shutdown, releases it for another replica
+// A lease lost to another owner cancels its heights right away so only the new owner indexes them
+func (s *service) holdHeightLease(lease *storage.HeightLease, queued <-chan bool, cancelLease context.CancelFunc) {
+	defer s.backgroundProcesses.Done()
+	defer cancelLease()
+
+	queuedAll, isQueued := false, false
+
+	for !isQueued || s.heightsInFlight.isRangeScheduled(lease.FromHeight, lease.ToHeight) {
+		select {
+		case queuedAll = <-queued:
+			isQueued = true
+		case <-time.After(s.leasing.heartbeatInterval):
+			err := s.driver.RenewHeightLease(lease, s.leasing.duration)
+			if errors.Is(err, storage.ErrHeightLeaseLost) {
+				s.logErrorWithFields("Height lease lost", lease.FromHeight, err)
+				leasesLostCounter.Inc()
+
+				return
+			}
+
+			if err != nil {
+				s.logErrorWithFields("Renew height lease failed", lease.FromHeight, err)
+			}
+		case <-s.drain.expired:
+			s.releaseHeightLease(lease)
+			return
+		}
+	}
+
+	if !queuedAll || s.heightsInFlight.isRangeAborted(lease.FromHeight, lease.ToHeight) {
+		s.releaseHeightLease(lease)
+		return
+	}
+
+	err := s.driver.CompleteHeightLease(lease)
+	if err != nil {
+		s.logErrorWithFields("Complete height lease failed", lease.FromHeight, err)
+		return
+	}
+
+	s.logLease("Height lease completed", lease)
+	leasesCompletedCounter.Inc()
+}
+
+func (s *service) releaseHeightLease(lease *storage.HeightLease) {
+	err := s.driver.ReleaseHeightLease(lease)
+	if err != nil {
+		s.logErrorWithFields("Release height lease failed", lease.FromHeight, err)
+		return
+	}
+
+	s.logLease("Height lease released", lease)
+}
+
+func (s *service) logLease(message string, lease *storage.HeightLease) {
+	log.WithFields(logrus.Fields{
+		"owner":       lease.Owner,
+		"from_height": lease.FromHeight,
+		"to_height":   lease.ToHeight,
+		"claims":      lease.Claims,
+	}).Info(fmt.Sprintf("%s from height %d to height %d", message, lease.FromHeight, lease.ToHeight))
+}
@@ -0,0 +1,87 @@
+package main
+
+import (
+	"context"
+	"sync"
+)
+
+// limiter is a counting semaphore whose limit can change while it is in use,
+// lowering the limit lets the units in use drain before new ones are acquired
+type limiter struct {
+	mu      sync.Mutex
+	limit   int64
+	inUse   int64
+	waited  bool
+	changed chan struct{}
+}
+
+func newLimiter(limit int64) *limiter {
+	semaphoreLimitGauge.Set(float64(limit))
+
+	return &limiter{
+		limit:   limit,
+		changed: make(chan struct{}),
+	}
+}
+
+// Acquire blocks until n units are available or ctx is cancelled,
+// more units than the limit are only acquired when none are in use
+func (l *limiter) Acquire(ctx context.Context, n int64) error {
+	for {
+		l.mu.Lock()
+
+		if l.inUse+n <= l.limit || l.inUse == 0 {
+			l.inUse += n
+			l.mu.Unlock()
+
+			return nil
+		}
+
+		changed := l.changed
+		l.waited = true
+		l.mu.Unlock()
+
+		select {
+		case <-changed:
+		case <-ctx.Done():
+			return ctx.Err()
+		}
+	}
+}
+
+// Release returns n units
+func (l *limiter) Release(n int64) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	l.inUse -= n
+	l.notify()
+}
+
+// SetLimit changes the units that can be in use at the same time
+func (l *limiter) SetLimit(limit int64) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	l.limit = limit
+	l.notify()
+
+	semaphoreLimitGauge.Set(float64(limit))
+}
+
+// takeWaited reports whether an Acquire had to wait for units since the last call
+func (l *limiter) takeWaited() bool {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	waited := l.waited
+	l.waited = false
+
+	return waited
+}
+
+// notify wakes up every Acquire waiting for units
+func (l *limiter) notify() {
+	close(l.changed)
+	l.changed = make(chan struct{})
+}
@@ -0,0 +1,57 @@
+package main
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestLimiter_SetLimit(t *testing.T) {
+	c := require.New(t)
+
+	semaphore := newLimiter(2)
+	ctx := context.Background()
+
+	c.NoError(semaphore.Acquire(ctx, 2))
+	c.False(semaphore.takeWaited())
+
+	acquired := make(chan error)
+
+	go func() {
+		acquired <- semaphore.Acquire(ctx, 1)
+	}()
+
+	select {
+	case <-acquired:
+		c.Fail("acquired over the limit")
+	case <-time.After(20 * time.Millisecond):
+	}
+
+	semaphore.SetLimit(3)
+	c.NoError(<-acquired)
+	c.True(semaphore.takeWaited())
+
+	// Lowering the limit lets the units in use drain before new ones are acquired
+	semaphore.SetLimit(1)
+	semaphore.Release(2)
+
+	timeoutCtx, cancel := context.WithTimeout(ctx, 20*time.Millisecond)
+	defer cancel()
+
+	c.ErrorIs(semaphore.Acquire(timeoutCtx, 1), context.DeadlineExceeded)
+
+	semaphore.Release(1)
+	c.NoError(semaphore.Acquire(ctx, 1))
+}
+
+func TestLimiter_AcquireOverLimit(t *testing.T) {
+	c := require.New(t)
+
+	semaphore := newLimiter(2)
+
+	// More units than the limit are only acquired when none are in use
+	c.NoError(semaphore.Acquire(context.Background(), 5))
+	c.Equal(int64(5), semaphore.inUse)
+}
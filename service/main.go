@@ -5,36 +5,23 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"os"
+	"strings"
 	"sync"
 	"time"
 
 	providerlib "github.com/pokt-foundation/pocket-go/provider"
 	indexerlib "github.com/pokt-foundation/pocket-indexer-lib"
-	postgresdriver "github.com/pokt-foundation/pocket-indexer-lib/postgres-driver"
-	"github.com/pokt-foundation/utils-go/environment"
+	"github.com/pokt-foundation/pocket-indexer-services/config"
+	"github.com/pokt-foundation/pocket-indexer-services/storage"
 	"github.com/sirupsen/logrus"
-	"golang.org/x/sync/semaphore"
 )
 
 var (
 	errToHeightLowerThanFromHeight          = errors.New("to height is lower than from height")
 	errInputHeightIsHigherThanCurrentHeight = errors.New("input height is higher than current height")
 
-	indexingProcesses sync.WaitGroup
-	semaphoreLimiter  *semaphore.Weighted
-
 	log = logrus.New()
-
-	clientTimeout    = environment.GetInt64("CLIENT_TIMEOUT", 60000)
-	clientRetries    = environment.GetInt64("CLIENT_RETRIES", 3)
-	serviceRetries   = environment.GetInt64("SERVICE_RETRIES", 3)
-	concurrency      = environment.GetInt64("CONCURRENCY", 100)
-	reqInterval      = environment.GetInt64("REQUEST_INTERVAL", 5000)
-	connectionString = environment.GetString("CONNECTION_STRING", "")
-	mainNode         = environment.GetString("MAIN_NODE", "")
-	fallbackNode     = environment.GetString("FALLBACK_NODE", "")
-	fromHeight       = int(environment.GetInt64("FROM_HEIGHT", -1))
-	toHeight         = int(environment.GetInt64("TO_HEIGHT", -1))
 )
 
 func init() {
@@ -70,30 +57,82 @@ type driver interface {
 	WriteAccount(account *indexerlib.Account) error
 	WriteNodes(nodes []*indexerlib.Node) error
 	WriteApps(apps []*indexerlib.App) error
+	WriteFailedHeight(failedHeight *storage.FailedHeight, options *storage.WriteFailedHeightOptions) (*storage.FailedHeight, error)
+	ClaimRetryableFailedHeights(limit int, stages []string, claimDuration time.Duration) ([]*storage.FailedHeight, error)
+	DeleteFailedHeight(failedHeight *storage.FailedHeight) error
+	ReadMissingHeights(fromHeight, toHeight int) ([]*storage.MissingHeight, error)
+	ReadMissingAccounts(height int) ([]*storage.MissingAccount, error)
+	ReadTransactionAddresses(height int) ([]string, error)
+	ReadBlockByHeight(height int) (*indexerlib.Block, error)
+	WriteBlockHashMismatch(mismatch *storage.BlockHashMismatch) error
+	DeleteHeight(height int, stages []string) error
+	ReadProgress(name string) (int, error)
+	WriteProgress(name string, height int) error
+	ReadFailedHeightsFromHeight(fromHeight int) ([]*storage.FailedHeight, error)
+	CountFailedHeights() (*storage.FailedHeightsCount, error)
+	WriteDeadLetter(deadLetter *storage.DeadLetter) error
+	ReadDeadLetters(limit int) ([]*storage.DeadLetter, error)
+	ReadDeadLetter(id int) (*storage.DeadLetter, error)
+	DeleteDeadLetter(deadLetter *storage.DeadLetter) error
+	ClaimHeightLease(options *storage.ClaimHeightLeaseOptions) (*storage.HeightLease, error)
+	RenewHeightLease(lease *storage.HeightLease, duration time.Duration) error
+	CompleteHeightLease(lease *storage.HeightLease) error
+	ReleaseHeightLease(lease *storage.HeightLease) error
+	TryLeaderLock(ctx context.Context, name string) (*storage.LeaderLock, error)
 }
 
 // service struct handler for all necessary fiels for indexing
 type service struct {
-	indexer          indexer
-	fallbackIndexer  indexer
-	provider         provider
-	fallbackProvider provider
-	driver           driver
-	hasEnd           bool
-	fromHeight       int
-	toHeight         int
-	retries          int64
-	concurrency      int64
-	reqInterval      time.Duration
-	mainNode         string
-	fallbackNode     string
+	indexer              indexer
+	fallbackIndexer      indexer
+	provider             provider
+	fallbackProvider     provider
+	driver               driver
+	mode                 string
+	stages               []string
+	trackedStages        []string
+	fromHeight           int
+	toHeight             int
+	snapshotAddresses    []string
+	snapshotStep         int
+	retryPolicy          *retryPolicy
+	concurrency          int64
+	accountConcurrency   int
+	accountQueue         *accountQueue
+	controller           *concurrencyController
+	reqInterval          *int64
+	queueSize            int
+	checkpointInterval   time.Duration
+	providerPool         *providerPool
+	nodeProbeInterval    time.Duration
+	nodes                string
+	redriveInterval      time.Duration
+	redriveBatchSize     int
+	failedHeightOpts     *storage.WriteFailedHeightOptions
+	deadLetterFile       string
+	leasing              *leasing
+	election             *leaderElection
+	gapSweepInterval     time.Duration
+	reorgCheckDepth      int
+	shutdownTimeout      time.Duration
+	drain                *drain
+	heightsInFlight      *heightTracker
+	semaphore            *limiter
+	indexingProcesses    *sync.WaitGroup
+	backgroundProcesses  *sync.WaitGroup
+	port                 string
+	livenessTimeout      time.Duration
+	readinessMaxLag      int
+	loadConfig           configLoader
+	configPath           string
+	configReloadInterval time.Duration
 }
 
 func (s *service) logErrorWithFields(message string, height int, err error) {
 	fields := logrus.Fields{
-		"main_node":     s.mainNode,
-		"fallback_node": s.fallbackNode,
-		"err":           err.Error(),
+		"nodes":       s.nodes,
+		"err":         err.Error(),
+		"error_class": classifyError(err),
 	}
 
 	if height > 0 {
@@ -105,9 +144,8 @@ func (s *service) logErrorWithFields(message string, height int, err error) {
 
 func (s *service) logInfoWithFields(message, address string, height int) {
 	fields := logrus.Fields{
-		"main_node":     s.mainNode,
-		"fallback_node": s.fallbackNode,
-		"height":        height,
+		"nodes":  s.nodes,
+		"height": height,
 	}
 
 	if address != "" {
@@ -117,383 +155,554 @@ func (s *service) logInfoWithFields(message, address string, height int) {
 	log.WithFields(fields).Info(fmt.Sprintf("%s with height: %d", message, height))
 }
 
-func (s *service) start() error {
+// start indexes the heights of the mode, with leader election the replica following waits as a standby
+// until it leads and goes back to standby whenever it loses the leadership
+// The heights the last run left incomplete are returned with its error
+func (s *service) start(ctx context.Context) ([]int, error) {
 	for {
-		heightsToIndex, err := s.getHeightsToIndex()
+		term := s.newTerm()
+
+		leader, err := term.waitLeadership(ctx)
 		if err != nil {
-			return err
+			return nil, err
 		}
 
-		err = s.indexHeights(heightsToIndex)
-		if err != nil {
-			return err
+		if leader == nil {
+			err = term.run(ctx)
+			return term.heightsInFlight.incomplete(), err
 		}
 
-		if s.hasEnd {
-			break
+		err = term.lead(ctx, leader)
+		if !errors.Is(err, errLeadershipLost) {
+			return term.heightsInFlight.incomplete(), err
 		}
 
-		time.Sleep(s.reqInterval)
+		term.standBy()
 	}
+}
 
-	return nil
+// newTerm returns a copy of the service with the heights, processes and queues of a new run,
+// so the processes of a lost leadership still running after its standby never touch the ones of the next leadership
+func (s *service) newTerm() *service {
+	term := *s
+	term.drain = newDrain()
+	term.heightsInFlight = newHeightTracker()
+	term.indexingProcesses = &sync.WaitGroup{}
+	term.backgroundProcesses = &sync.WaitGroup{}
+	term.accountQueue = newAccountQueue(cap(s.accountQueue.tasks))
+
+	return &term
 }
 
-func (s *service) getHeightsToIndex() ([]int, error) {
-	maxSavedHeight, err := s.driver.GetMaxHeightInBlocks()
-	if err != nil && !errors.Is(err, postgresdriver.ErrNoPreviousHeight) {
-		return nil, err
-	}
+// lead follows the tip while the leader lock is held, the indexing is cancelled as soon as the lock is lost
+func (s *service) lead(ctx context.Context, leader *leadership) error {
+	leaderCtx, cancelLeadership := context.WithCancel(ctx)
+	defer cancelLeadership()
 
-	fromHeight := s.getFromHeight(maxSavedHeight, err)
+	watchCtx, stopWatching := context.WithCancel(leaderCtx)
+	watched := make(chan struct{})
 
-	currentHeight, err := s.provider.GetBlockHeight()
-	if err != nil {
-		currentHeight, err = s.fallbackProvider.GetBlockHeight()
-		if err != nil {
-			return nil, err
-		}
-	}
-
-	if s.hasEnd && s.toHeight > currentHeight {
-		return nil, errInputHeightIsHigherThanCurrentHeight
-	}
+	go func(drain *drain) {
+		defer close(watched)
 
-	toHeight := s.getToHeight(currentHeight)
+		s.watchLeadership(watchCtx, leader, cancelLeadership, drain)
+	}(s.drain)
 
-	var heightsToIndex []int
+	err := s.run(leaderCtx)
 
-	for i := fromHeight; i <= toHeight; i++ {
-		heightsToIndex = append(heightsToIndex, i)
-	}
+	stopWatching()
+	<-watched
 
-	return heightsToIndex, nil
+	return s.stepDown(leader, err)
 }
 
-func (s *service) getFromHeight(maxSavedHeight int64, getMaxHeightErr error) int {
-	if s.hasEnd {
-		return s.fromHeight
+func (s *service) run(ctx context.Context) error {
+	heartbeat()
+
+	s.bindProviders(ctx)
+
+	// The drain also expires once the run finishes so its timer is not left waiting for a cancellation
+	defer s.drain.expire()
+	go s.drain.expireAfter(ctx, s.shutdownTimeout)
+
+	s.semaphore = newLimiter(s.concurrency)
+
+	// The heights leased by other replicas never complete here so no committed height is tracked while leasing
+	if s.leasing == nil {
+		s.heightsInFlight.track(s.trackedStages)
 	}
 
-	// This error means nothing was saved in the database and it should start indexing from 1
-	if errors.Is(getMaxHeightErr, postgresdriver.ErrNoPreviousHeight) {
-		return 1
+	backgroundCtx, cancelBackground := context.WithCancel(ctx)
+
+	stopAccountWorkers := make(chan struct{})
+	s.runAccountWorkers(ctx, stopAccountWorkers)
+
+	if s.controller != nil {
+		s.backgroundProcesses.Add(1)
+
+		go func() {
+			defer s.backgroundProcesses.Done()
+
+			s.controller.run(backgroundCtx, s.semaphore)
+		}()
 	}
 
-	return int(maxSavedHeight) + 1
-}
+	s.backgroundProcesses.Add(2)
+
+	go func() {
+		defer s.backgroundProcesses.Done()
+
+		s.providerPool.probe(backgroundCtx, s.nodeProbeInterval)
+	}()
+	go s.redriveFailedHeights(backgroundCtx)
 
-func (s *service) getToHeight(currentHeight int) int {
-	if s.hasEnd {
-		return s.toHeight
+	if s.isCheckpointing() {
+		s.backgroundProcesses.Add(1)
+
+		go s.checkpointCommittedHeights(backgroundCtx)
 	}
 
-	return currentHeight
-}
+	if s.configPath != "" && s.loadConfig != nil {
+		s.backgroundProcesses.Add(1)
 
-func (s *service) indexHeights(heightsToIndex []int) error {
-	if len(heightsToIndex) == 0 {
-		return nil
+		go s.watchConfig(backgroundCtx)
 	}
 
-	semaphoreLimiter = semaphore.NewWeighted(s.concurrency)
+	err := s.schedule(ctx)
 
-	for _, height := range heightsToIndex {
-		indexingProcesses.Add(4)
+	close(stopAccountWorkers)
+	cancelBackground()
+	s.waitBackgroundProcesses()
 
-		err := semaphoreLimiter.Acquire(context.Background(), 4)
-		if err != nil {
-			return err
-		}
+	if ctx.Err() != nil {
+		s.abortQueuedAccounts(ctx)
+	}
 
-		go s.indexBlock(height)
-		go s.indexBlockTransactions(height)
-		go s.indexBlockNodes(height)
-		go s.indexBlockApps(height)
+	// The last checkpoint is saved once the heights in flight were drained
+	if s.isCheckpointing() {
+		s.writeCommittedHeights(make(map[string]int))
 	}
 
-	indexingProcesses.Wait()
+	return err
+}
 
-	return nil
+// bindProviders routes the provider and indexer calls of the run through views of the pool given up once ctx is cancelled,
+// so a shutdown does not wait for the calls in flight
+func (s *service) bindProviders(ctx context.Context) {
+	s.provider = s.providerPool.view(ctx, false)
+	s.fallbackProvider = s.providerPool.view(ctx, true)
+	s.indexer = indexerlib.NewIndexer(s.provider, s.driver)
+	s.fallbackIndexer = indexerlib.NewIndexer(s.fallbackProvider, s.driver)
 }
 
-func releaseProcess() {
-	indexingProcesses.Done()
-	semaphoreLimiter.Release(1)
+func (s *service) indexStage(ctx context.Context, stage string, height int) {
+	switch stage {
+	case storage.StageBlock:
+		s.indexBlock(ctx, height)
+	case storage.StageTransactions:
+		s.indexBlockTransactions(ctx, height)
+	case storage.StageNodes:
+		s.indexBlockNodes(ctx, height)
+	case storage.StageApps:
+		s.indexBlockApps(ctx, height)
+	case storage.StageAccounts:
+		s.indexMissingAccounts(ctx, height)
+	default:
+		s.releaseProcess(height, stage)
+		s.logErrorWithFields("Index stage failed", height, storage.ErrUnknownStage)
+	}
 }
 
-func (s *service) indexBlock(height int) {
-	defer releaseProcess()
+// releaseProcess releases everything the stage held before it is done, so nothing is left in use once the processes are waited for
+func (s *service) releaseProcess(height int, stage string) {
+	heartbeat()
+	s.heightsInFlight.done(height, stage)
+	s.semaphore.Release(1)
+	semaphoreInUseGauge.Dec()
+	s.indexingProcesses.Done()
+}
 
-	err := s.indexBlockWithRetries(height, s.indexer)
-	if err != nil {
-		s.logErrorWithFields("Index block with main node failed", height, err)
+func (s *service) indexBlock(ctx context.Context, height int) {
+	defer s.releaseProcess(height, storage.StageBlock)
 
-		err = s.indexBlockWithRetries(height, s.fallbackIndexer)
-		if err != nil {
-			s.logErrorWithFields("Index block with fallback node failed", height, err)
-		}
+	err := s.indexBlockWithFallback(ctx, height)
+	observeStageResult(storage.StageBlock, err)
+	if err != nil {
+		s.failStage(ctx, height, storage.StageBlock, "", "", err)
+		return
 	}
 
 	s.logInfoWithFields("Block indexed successfully", "", height)
 }
 
-func (s *service) indexBlockWithRetries(height int, indexer indexer) error {
-	var retry int64
-	var err error
+func (s *service) indexBlockWithFallback(ctx context.Context, height int) error {
+	err := s.indexBlockWithRetries(ctx, height, s.indexer)
+	if err != nil {
+		s.logErrorWithFields("Index block with main node failed", height, err)
 
-	for {
-		err = indexer.IndexBlock(height)
-		if err == nil {
-			break
+		// Fallback is not tried when shutting down or when it would fail the same way
+		if ctx.Err() != nil || !canFallback(err) {
+			return err
 		}
 
-		retry++
+		fallbacksCounter.WithLabelValues(storage.StageBlock).Inc()
 
-		if retry == s.retries {
-			break
+		err = s.indexBlockWithRetries(ctx, height, s.fallbackIndexer)
+		if err != nil {
+			s.logErrorWithFields("Index block with fallback node failed", height, err)
 		}
 	}
 
 	return err
 }
 
-func (s *service) indexBlockTransactions(height int) {
-	defer releaseProcess()
+func (s *service) indexBlockWithRetries(ctx context.Context, height int, indexer indexer) error {
+	return s.retryPolicy.do(ctx, storage.StageBlock, func() error {
+		return indexer.IndexBlock(height)
+	})
+}
 
-	err := s.indexBlockTransactionsWithRetries(height, s.indexer)
-	if err != nil {
-		s.logErrorWithFields("Index block with main node failed", height, err)
+func (s *service) indexBlockTransactions(ctx context.Context, height int) {
+	defer s.releaseProcess(height, storage.StageTransactions)
 
-		err = s.indexBlockTransactionsWithRetries(height, s.fallbackIndexer)
-		if err != nil {
-			s.logErrorWithFields("Index block with fallback node failed", height, err)
-		}
+	addresses, err := s.indexBlockTransactionsWithAddresses(ctx, height)
+	err = ignoreNothingToIndex(err)
+	observeStageResult(storage.StageTransactions, err)
+	if err != nil {
+		s.failStage(ctx, height, storage.StageTransactions, "", "", err)
+		return
 	}
 
+	s.indexAccounts(ctx, addresses, height, storage.AccountTypeWallet)
+
 	s.logInfoWithFields("Block transactions indexed successfully", "", height)
 }
 
-func (s *service) indexBlockTransactionsWithRetries(height int, indexer indexer) error {
-	var retry int64
-	var err error
+// indexBlockTransactionsWithAddresses indexes the transactions of the height and returns the addresses taking part in them
+func (s *service) indexBlockTransactionsWithAddresses(ctx context.Context, height int) ([]string, error) {
+	err := s.indexBlockTransactionsWithFallback(ctx, height)
+	if err != nil {
+		return nil, err
+	}
+
+	return s.driver.ReadTransactionAddresses(height)
+}
+
+func (s *service) indexBlockTransactionsWithFallback(ctx context.Context, height int) error {
+	err := s.indexBlockTransactionsWithRetries(ctx, height, s.indexer)
+	if err != nil {
+		s.logErrorWithFields("Index block with main node failed", height, err)
 
-	for {
-		err = indexer.IndexBlockTransactions(height)
-		if err == nil {
-			break
+		if ctx.Err() != nil || !canFallback(err) {
+			return err
 		}
 
-		retry++
+		fallbacksCounter.WithLabelValues(storage.StageTransactions).Inc()
 
-		if retry == s.retries {
-			break
+		err = s.indexBlockTransactionsWithRetries(ctx, height, s.fallbackIndexer)
+		if err != nil {
+			s.logErrorWithFields("Index block with fallback node failed", height, err)
 		}
 	}
 
 	return err
 }
 
-func (s *service) indexBlockNodes(height int) {
-	defer releaseProcess()
+func (s *service) indexBlockTransactionsWithRetries(ctx context.Context, height int, indexer indexer) error {
+	return s.retryPolicy.do(ctx, storage.StageTransactions, func() error {
+		return indexer.IndexBlockTransactions(height)
+	})
+}
+
+func (s *service) indexBlockNodes(ctx context.Context, height int) {
+	defer s.releaseProcess(height, storage.StageNodes)
+
+	addresses, err := s.indexBlockNodesWithFallback(ctx, height)
+	err = ignoreNothingToIndex(err)
+	observeStageResult(storage.StageNodes, err)
+	if err != nil {
+		s.failStage(ctx, height, storage.StageNodes, "", "", err)
+		return
+	}
 
-	addresses, err := s.indexBlockNodesWithRetries(height, s.indexer)
+	s.indexAccounts(ctx, addresses, height, indexerlib.AccountTypeNode)
+
+	s.logInfoWithFields("Block nodes indexed successfully", "", height)
+}
+
+func (s *service) indexBlockNodesWithFallback(ctx context.Context, height int) ([]string, error) {
+	addresses, err := s.indexBlockNodesWithRetries(ctx, height, s.indexer)
 	if err != nil {
 		s.logErrorWithFields("Index nodes with main node failed", height, err)
 
-		addresses, err = s.indexBlockNodesWithRetries(height, s.fallbackIndexer)
+		if ctx.Err() != nil || !canFallback(err) {
+			return addresses, err
+		}
+
+		fallbacksCounter.WithLabelValues(storage.StageNodes).Inc()
+
+		addresses, err = s.indexBlockNodesWithRetries(ctx, height, s.fallbackIndexer)
 		if err != nil {
 			s.logErrorWithFields("Index nodes with fallback node failed", height, err)
 		}
 	}
 
-	s.indexAccounts(addresses, height, indexerlib.AccountTypeNode)
-
-	s.logInfoWithFields("Block nodes indexed successfully", "", height)
+	return addresses, err
 }
 
-func (s *service) indexBlockNodesWithRetries(height int, indexer indexer) ([]string, error) {
-	var retry int64
-	var err error
+func (s *service) indexBlockNodesWithRetries(ctx context.Context, height int, indexer indexer) ([]string, error) {
 	var addresses []string
 
-	for {
+	err := s.retryPolicy.do(ctx, storage.StageNodes, func() error {
+		var err error
 		addresses, err = indexer.IndexBlockNodes(height)
-		if err == nil {
-			break
-		}
+		return err
+	})
 
-		retry++
+	return addresses, err
+}
 
-		if retry == s.retries {
-			break
-		}
+func (s *service) indexBlockApps(ctx context.Context, height int) {
+	defer s.releaseProcess(height, storage.StageApps)
+
+	addresses, err := s.indexBlockAppsWithFallback(ctx, height)
+	err = ignoreNothingToIndex(err)
+	observeStageResult(storage.StageApps, err)
+	if err != nil {
+		s.failStage(ctx, height, storage.StageApps, "", "", err)
+		return
 	}
 
-	return addresses, err
-}
+	s.indexAccounts(ctx, addresses, height, indexerlib.AccountTypeApp)
 
-func (s *service) indexBlockApps(height int) {
-	defer releaseProcess()
+	s.logInfoWithFields("Block apps indexed successfully", "", height)
+}
 
-	addresses, err := s.indexBlockAppsWithRetries(height, s.indexer)
+func (s *service) indexBlockAppsWithFallback(ctx context.Context, height int) ([]string, error) {
+	addresses, err := s.indexBlockAppsWithRetries(ctx, height, s.indexer)
 	if err != nil {
 		s.logErrorWithFields("Index apps with main node failed", height, err)
 
-		addresses, err = s.indexBlockAppsWithRetries(height, s.fallbackIndexer)
+		if ctx.Err() != nil || !canFallback(err) {
+			return addresses, err
+		}
+
+		fallbacksCounter.WithLabelValues(storage.StageApps).Inc()
+
+		addresses, err = s.indexBlockAppsWithRetries(ctx, height, s.fallbackIndexer)
 		if err != nil {
 			s.logErrorWithFields("Index apps with fallback node failed", height, err)
 		}
 	}
 
-	s.indexAccounts(addresses, height, indexerlib.AccountTypeApp)
-
-	s.logInfoWithFields("Block apps indexed successfully", "", height)
+	return addresses, err
 }
 
-func (s *service) indexBlockAppsWithRetries(height int, indexer indexer) ([]string, error) {
-	var retry int64
-	var err error
+func (s *service) indexBlockAppsWithRetries(ctx context.Context, height int, indexer indexer) ([]string, error) {
 	var addresses []string
 
-	for {
+	err := s.retryPolicy.do(ctx, storage.StageApps, func() error {
+		var err error
 		addresses, err = indexer.IndexBlockApps(height)
-		if err == nil {
-			break
-		}
-
-		retry++
-
-		if retry == s.retries {
-			break
-		}
-	}
+		return err
+	})
 
 	return addresses, err
 }
 
-func (s *service) indexAccounts(addresses []string, height int, accountType indexerlib.AccountType) {
+// indexAccounts fans out the accounts of the height to the account queue, unless the accounts stage is disabled
+func (s *service) indexAccounts(ctx context.Context, addresses []string, height int, accountType indexerlib.AccountType) {
+	if !containsStage(s.trackedStages, storage.StageAccounts) {
+		return
+	}
+
 	for _, address := range addresses {
-		indexingProcesses.Add(1)
+		task := &accountTask{height: height, address: address, accountType: accountType}
 
-		err := semaphoreLimiter.Acquire(context.Background(), 1)
-		if err != nil {
-			s.logErrorWithFields("Set concurrency for indexing accounts failed", height, err)
+		if !s.queueAccount(ctx, task) {
+			s.failStage(ctx, height, storage.StageAccounts, address, accountType, ctx.Err())
 		}
-
-		go s.indexAccount(address, height, accountType)
 	}
 }
 
-func (s *service) indexAccount(address string, height int, accountType indexerlib.AccountType) {
-	defer releaseProcess()
+func (s *service) indexAccount(ctx context.Context, task *accountTask) {
+	defer s.releaseAccount(task.height)
+	defer s.accountQueue.unmark(task)
 
-	err := s.indexAccountWithRetries(address, height, accountType, s.indexer)
+	err := s.indexAccountWithFallback(ctx, task.address, task.height, task.accountType)
+	observeStageResult(storage.StageAccounts, err)
 	if err != nil {
-		s.logErrorWithFields("Index account with main node failed", height, err)
-
-		err = s.indexAccountWithRetries(address, height, accountType, s.fallbackIndexer)
-		if err != nil {
-			s.logErrorWithFields("Index account with fallback node failed", height, err)
-		}
+		s.failStage(ctx, task.height, storage.StageAccounts, task.address, task.accountType, err)
+		return
 	}
 
-	s.logInfoWithFields("Account indexed successfully", address, height)
+	s.logInfoWithFields("Account indexed successfully", task.address, task.height)
 }
 
-func (s *service) indexAccountWithRetries(address string, height int, accountType indexerlib.AccountType, indexer indexer) error {
-	var retry int64
-	var err error
+func (s *service) indexAccountWithFallback(ctx context.Context, address string, height int, accountType indexerlib.AccountType) error {
+	err := s.indexAccountWithRetries(ctx, address, height, accountType, s.indexer)
+	if err != nil {
+		s.logErrorWithFields("Index account with main node failed", height, err)
 
-	for {
-		err = indexer.IndexAccount(address, height, accountType)
-		if err == nil {
-			break
+		if ctx.Err() != nil || !canFallback(err) {
+			return err
 		}
 
-		retry++
+		fallbacksCounter.WithLabelValues(storage.StageAccounts).Inc()
 
-		if retry == s.retries {
-			break
+		err = s.indexAccountWithRetries(ctx, address, height, accountType, s.fallbackIndexer)
+		if err != nil {
+			s.logErrorWithFields("Index account with fallback node failed", height, err)
 		}
 	}
 
 	return err
 }
 
-func getFallbacks(fallbackNode string, driver driver) (provider, indexer) {
-	if fallbackNode == "" {
-		return nil, nil
-	}
-
-	fallbackProvider := providerlib.NewProvider(fallbackNode, nil)
+func (s *service) indexAccountWithRetries(ctx context.Context, address string, height int, accountType indexerlib.AccountType, indexer indexer) error {
+	return s.retryPolicy.do(ctx, storage.StageAccounts, func() error {
+		return indexer.IndexAccount(address, height, accountType)
+	})
+}
 
-	fallbackProvider.UpdateRequestConfig(int(clientRetries), time.Duration(clientTimeout)*time.Millisecond)
+// setupService sets up the service of the mode, creating the tables only for the commands that index
+// so the read-only ones run under a read-only role and take no schema locks
+func setupService(serviceConfig *config.Config, mode string, createTables bool) (*service, error) {
+	options := serviceConfig.Service
+	nodeURLs := getNodeURLs(options.MainNode, options.FallbackNode, options.Nodes)
+	stages, trackedStages := getEnabledStages(options.Stages)
 
-	fallbackIndexer := indexerlib.NewIndexer(fallbackProvider, driver)
+	pool, err := newProviderPool(nodeURLs, options.NodePool)
+	if err != nil {
+		return nil, err
+	}
 
-	return fallbackProvider, fallbackIndexer
-}
+	pool.updateRequestConfig(int(options.ClientRetries), milliseconds(options.ClientTimeout))
 
-func (s *service) setOptionalParams(fromHeight, toHeight int) error {
-	if fromHeight > 0 && toHeight > 0 {
-		if toHeight < fromHeight {
-			return errToHeightLowerThanFromHeight
-		}
+	concurrency := options.Concurrency
 
-		s.hasEnd = true
-		s.fromHeight = fromHeight
-		s.toHeight = toHeight
+	if options.AdaptiveConcurrency.Enabled {
+		pool.controller = newConcurrencyController(concurrency, options.AdaptiveConcurrency)
+		concurrency = pool.controller.getLimit()
 	}
 
-	return nil
-}
-
-func setupService() (*service, error) {
-	mainProvider := providerlib.NewProvider(mainNode, nil)
+	leasing, err := newLeasing(options.Leases)
+	if err != nil {
+		return nil, err
+	}
 
-	mainProvider.UpdateRequestConfig(int(clientRetries), time.Duration(clientTimeout)*time.Millisecond)
+	// The commands that do not run bind their calls to no cancellation
+	mainProvider := pool.view(context.Background(), false)
+	fallbackProvider := pool.view(context.Background(), true)
 
-	driver, err := postgresdriver.NewPostgresDriverFromConnectionString(connectionString)
+	postgresDriver, err := storage.NewPostgresDriverFromConnectionString(serviceConfig.ConnectionString)
 	if err != nil {
 		return nil, err
 	}
 
+	if createTables {
+		err = postgresDriver.CreateTables()
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	driver := newInstrumentedDriver(postgresDriver)
+
 	mainIndexer := indexerlib.NewIndexer(mainProvider, driver)
+	fallbackIndexer := indexerlib.NewIndexer(fallbackProvider, driver)
 
-	fallbackProvider, fallbackIndexer := getFallbacks(fallbackNode, driver)
+	reqInterval := int64(milliseconds(options.RequestInterval))
 
 	service := &service{
-		indexer:          mainIndexer,
-		fallbackIndexer:  fallbackIndexer,
-		provider:         mainProvider,
-		fallbackProvider: fallbackProvider,
-		driver:           driver,
-		retries:          serviceRetries,
-		concurrency:      concurrency,
-		reqInterval:      time.Duration(reqInterval) * time.Millisecond,
-		mainNode:         mainNode,
-		fallbackNode:     fallbackNode,
-	}
-
-	err = service.setOptionalParams(fromHeight, toHeight)
-	if err != nil {
-		return nil, err
+		indexer:           mainIndexer,
+		fallbackIndexer:   fallbackIndexer,
+		provider:          mainProvider,
+		fallbackProvider:  fallbackProvider,
+		driver:            driver,
+		mode:              mode,
+		stages:            stages,
+		trackedStages:     trackedStages,
+		fromHeight:        options.FromHeight,
+		toHeight:          options.ToHeight,
+		snapshotAddresses: options.SnapshotAddresses,
+		snapshotStep:      int(options.SnapshotStep),
+		retryPolicy: &retryPolicy{
+			maxAttempts:     options.ServiceRetries,
+			initialInterval: milliseconds(options.Retry.InitialInterval),
+			maxInterval:     milliseconds(options.Retry.MaxInterval),
+			maxElapsedTime:  milliseconds(options.Retry.MaxElapsedTime),
+		},
+		concurrency:        concurrency,
+		accountConcurrency: int(options.AccountConcurrency),
+		accountQueue:       newAccountQueue(int(options.AccountQueueSize)),
+		controller:         pool.controller,
+		reqInterval:        &reqInterval,
+		queueSize:          int(options.QueueSize),
+		checkpointInterval: milliseconds(options.CheckpointInterval),
+		providerPool:       pool,
+		nodeProbeInterval:  milliseconds(options.NodePool.ProbeInterval),
+		nodes:              strings.Join(nodeURLs, ","),
+		redriveInterval:    milliseconds(options.Redrive.Interval),
+		redriveBatchSize:   int(options.Redrive.BatchSize),
+		failedHeightOpts: &storage.WriteFailedHeightOptions{
+			RetryInterval:    milliseconds(options.Redrive.RetryInterval),
+			MaxRetryInterval: milliseconds(options.Redrive.MaxRetryInterval),
+			MaxAttempts:      int(options.Redrive.MaxAttempts),
+		},
+		deadLetterFile:       options.DeadLetterFile,
+		leasing:              leasing,
+		election:             newLeaderElection(options.LeaderElection),
+		gapSweepInterval:     milliseconds(options.GapSweepInterval),
+		reorgCheckDepth:      int(options.ReorgCheckDepth),
+		shutdownTimeout:      milliseconds(options.ShutdownTimeout),
+		heightsInFlight:      newHeightTracker(),
+		indexingProcesses:    &sync.WaitGroup{},
+		backgroundProcesses:  &sync.WaitGroup{},
+		port:                 options.Port,
+		livenessTimeout:      milliseconds(options.LivenessTimeout),
+		readinessMaxLag:      int(options.ReadinessMaxLag),
+		configPath:           serviceConfig.Path,
+		configReloadInterval: milliseconds(options.ConfigReloadInterval),
 	}
 
 	return service, nil
 }
 
-func main() {
-	service, err := setupService()
-	if err != nil {
-		log.WithError(err).Error(fmt.Sprintf("Setup service failed with error: %s", err.Error()))
+// getEnabledStages returns the stages launched for every height and the stages tracked out of the enabled ones,
+// accounts are fanned out from transactions, nodes and apps so they are only launched on their own when all are disabled
+func getEnabledStages(enabledStages []string) ([]string, []string) {
+	var stages, trackedStages []string
+
+	fansOutAccounts := false
+
+	for _, stage := range storage.Stages {
+		if !containsStage(enabledStages, stage) || stage == storage.StageAccounts {
+			continue
+		}
+
+		stages = append(stages, stage)
+		fansOutAccounts = fansOutAccounts || fanOutStages[stage]
 	}
 
-	err = service.start()
-	if err != nil {
-		service.logErrorWithFields("Start service failed", -1, err)
+	trackedStages = append(trackedStages, stages...)
+
+	if containsStage(enabledStages, storage.StageAccounts) {
+		trackedStages = append(trackedStages, storage.StageAccounts)
+
+		if !fansOutAccounts {
+			stages = append(stages, storage.StageAccounts)
+		}
 	}
 
-	log.Info("Execution finished successfully")
+	return stages, trackedStages
+}
+
+// milliseconds returns the duration of the milliseconds set in the configuration
+func milliseconds(value int64) time.Duration {
+	return time.Duration(value) * time.Millisecond
+}
+
+func main() {
+	os.Exit(run(os.Args))
 }
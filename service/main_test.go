@@ -0,0 +1,266 @@
+package main
+
+import (
+	"database/sql"
+	"errors"
+	"sync"
+	"testing"
+	"time"
+
+	providerlib "github.com/pokt-foundation/pocket-go/provider"
+	indexerlib "github.com/pokt-foundation/pocket-indexer-lib"
+	"github.com/pokt-foundation/pocket-indexer-services/storage"
+	"github.com/stretchr/testify/require"
+)
+
+// fakeIndexer indexes through the functions set, the ones not set succeed without indexing anything
+type fakeIndexer struct {
+	indexBlock   func(height int) error
+	indexNodes   func(height int) ([]string, error)
+	indexAccount func(address string, height int, accountType indexerlib.AccountType) error
+
+	mu       sync.Mutex
+	accounts []accountTask
+}
+
+func (i *fakeIndexer) IndexBlockTransactions(blockHeight int) error {
+	return nil
+}
+
+func (i *fakeIndexer) IndexBlock(blockHeight int) error {
+	if i.indexBlock == nil {
+		return nil
+	}
+
+	return i.indexBlock(blockHeight)
+}
+
+func (i *fakeIndexer) IndexBlockNodes(blockHeight int) ([]string, error) {
+	if i.indexNodes == nil {
+		return nil, indexerlib.ErrNoNodesToIndex
+	}
+
+	return i.indexNodes(blockHeight)
+}
+
+func (i *fakeIndexer) IndexBlockApps(blockHeight int) ([]string, error) {
+	return nil, indexerlib.ErrNoAppsToIndex
+}
+
+func (i *fakeIndexer) IndexAccount(address string, blockHeight int, accountType indexerlib.AccountType) error {
+	i.mu.Lock()
+	i.accounts = append(i.accounts, accountTask{height: blockHeight, address: address, accountType: accountType})
+	i.mu.Unlock()
+
+	if i.indexAccount == nil {
+		return nil
+	}
+
+	return i.indexAccount(address, blockHeight, accountType)
+}
+
+func (i *fakeIndexer) getAccounts() []accountTask {
+	i.mu.Lock()
+	defer i.mu.Unlock()
+
+	return append([]accountTask{}, i.accounts...)
+}
+
+// fakeProvider answers the blocks of the hashes set, the methods not overridden panic through the nil provider embedded
+type fakeProvider struct {
+	provider
+
+	hashes      map[int]string
+	chainHeight int
+}
+
+func (p *fakeProvider) GetBlockHeight() (int, error) {
+	return p.chainHeight, nil
+}
+
+func (p *fakeProvider) GetBlock(blockNumber int) (*providerlib.GetBlockOutput, error) {
+	block := &providerlib.GetBlockOutput{}
+	block.BlockID.Hash = p.hashes[blockNumber]
+
+	return block, nil
+}
+
+// fakeDriver keeps the failed heights and dead letters written in memory,
+// the methods not overridden panic through the nil driver embedded
+type fakeDriver struct {
+	driver
+
+	mu                   sync.Mutex
+	failedHeights        []*storage.FailedHeight
+	deletedFailedHeights []*storage.FailedHeight
+	deadLetters          []*storage.DeadLetter
+	deletedDeadLetters   []*storage.DeadLetter
+	missingAccounts      []*storage.MissingAccount
+	maxHeight            int64
+	blocks               map[int]*indexerlib.Block
+	mismatches           []*storage.BlockHashMismatch
+}
+
+func (d *fakeDriver) WriteFailedHeight(failedHeight *storage.FailedHeight, options *storage.WriteFailedHeightOptions) (*storage.FailedHeight, error) {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+
+	attempts := 1
+
+	for _, recorded := range d.failedHeights {
+		if recorded.Height == failedHeight.Height && recorded.Stage == failedHeight.Stage && recorded.Address == failedHeight.Address {
+			attempts++
+		}
+	}
+
+	recorded := *failedHeight
+	recorded.Attempts = attempts
+	recorded.Poisoned = attempts >= options.MaxAttempts
+
+	d.failedHeights = append(d.failedHeights, &recorded)
+
+	return &recorded, nil
+}
+
+func (d *fakeDriver) DeleteFailedHeight(failedHeight *storage.FailedHeight) error {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+
+	d.deletedFailedHeights = append(d.deletedFailedHeights, failedHeight)
+
+	return nil
+}
+
+func (d *fakeDriver) WriteDeadLetter(deadLetter *storage.DeadLetter) error {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+
+	d.deadLetters = append(d.deadLetters, deadLetter)
+
+	return nil
+}
+
+func (d *fakeDriver) GetMaxHeightInBlocks() (int64, error) {
+	return d.maxHeight, nil
+}
+
+func (d *fakeDriver) ReadFailedHeightsFromHeight(fromHeight int) ([]*storage.FailedHeight, error) {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+
+	var failedHeights []*storage.FailedHeight
+
+	for _, failedHeight := range d.failedHeights {
+		if failedHeight.Height >= fromHeight {
+			failedHeights = append(failedHeights, failedHeight)
+		}
+	}
+
+	return failedHeights, nil
+}
+
+func (d *fakeDriver) DeleteDeadLetter(deadLetter *storage.DeadLetter) error {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+
+	d.deletedDeadLetters = append(d.deletedDeadLetters, deadLetter)
+
+	return nil
+}
+
+func (d *fakeDriver) ReadMissingAccounts(height int) ([]*storage.MissingAccount, error) {
+	return d.missingAccounts, nil
+}
+
+func (d *fakeDriver) ReadBlockByHeight(height int) (*indexerlib.Block, error) {
+	block, ok := d.blocks[height]
+	if !ok {
+		return nil, sql.ErrNoRows
+	}
+
+	return block, nil
+}
+
+func (d *fakeDriver) WriteBlockHashMismatch(mismatch *storage.BlockHashMismatch) error {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+
+	d.mismatches = append(d.mismatches, mismatch)
+
+	return nil
+}
+
+func (d *fakeDriver) getFailedHeights() []*storage.FailedHeight {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+
+	return append([]*storage.FailedHeight{}, d.failedHeights...)
+}
+
+// newTestService returns a service indexing every stage through the indexer and the driver with a single attempt per call
+func newTestService(indexer indexer, driver driver) *service {
+	return &service{
+		indexer:         indexer,
+		fallbackIndexer: indexer,
+		driver:          driver,
+		stages:          storage.Stages[:len(storage.Stages)-1],
+		trackedStages:   storage.Stages,
+		retryPolicy: &retryPolicy{
+			maxAttempts:     1,
+			initialInterval: time.Millisecond,
+			maxInterval:     time.Millisecond,
+			maxElapsedTime:  time.Second,
+		},
+		accountQueue:        newAccountQueue(10),
+		heightsInFlight:     newHeightTracker(),
+		semaphore:           newLimiter(1),
+		indexingProcesses:   &sync.WaitGroup{},
+		backgroundProcesses: &sync.WaitGroup{},
+		failedHeightOpts: &storage.WriteFailedHeightOptions{
+			RetryInterval:    time.Second,
+			MaxRetryInterval: time.Minute,
+			MaxAttempts:      3,
+		},
+	}
+}
+
+func TestGetEnabledStages(t *testing.T) {
+	tests := []struct {
+		name          string
+		enabled       []string
+		stages        []string
+		trackedStages []string
+	}{
+		{
+			name:          "every stage",
+			enabled:       storage.Stages,
+			stages:        []string{storage.StageBlock, storage.StageTransactions, storage.StageNodes, storage.StageApps},
+			trackedStages: storage.Stages,
+		},
+		{
+			name:          "accounts fanned out by nodes",
+			enabled:       []string{storage.StageNodes, storage.StageAccounts},
+			stages:        []string{storage.StageNodes},
+			trackedStages: []string{storage.StageNodes, storage.StageAccounts},
+		},
+		{
+			name:          "accounts on their own",
+			enabled:       []string{storage.StageBlock, storage.StageAccounts},
+			stages:        []string{storage.StageBlock, storage.StageAccounts},
+			trackedStages: []string{storage.StageBlock, storage.StageAccounts},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			c := require.New(t)
+
+			stages, trackedStages := getEnabledStages(tt.enabled)
+
+			c.Equal(tt.stages, stages)
+			c.Equal(tt.trackedStages, trackedStages)
+		})
+	}
+}
+
+var errDummy = errors.New("dummy error")
@@ -0,0 +1,292 @@
+package main
+
+import (
+	"time"
+
+	providerlib "github.com/pokt-foundation/pocket-go/provider"
+	indexerlib "github.com/pokt-foundation/pocket-indexer-lib"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
+)
+
+const (
+	metricsNamespace = "indexer"
+
+	resultSuccess = "success"
+	resultFailure = "failure"
+)
+
+var (
+	indexedHeightGauge = promauto.NewGauge(prometheus.GaugeOpts{
+		Namespace: metricsNamespace,
+		Name:      "indexed_height",
+		Help:      "Max block height saved in the database",
+	})
+	committedHeightGauge = promauto.NewGaugeVec(prometheus.GaugeOpts{
+		Namespace: metricsNamespace,
+		Name:      "committed_height",
+		Help:      "Height every lower height was indexed through by stage",
+	}, []string{"stage"})
+	chainHeightGauge = promauto.NewGauge(prometheus.GaugeOpts{
+		Namespace: metricsNamespace,
+		Name:      "chain_height",
+		Help:      "Current block height of the chain reported by the nodes",
+	})
+	lagGauge = promauto.NewGauge(prometheus.GaugeOpts{
+		Namespace: metricsNamespace,
+		Name:      "lag_blocks",
+		Help:      "Blocks between the chain height and the indexed height",
+	})
+	stageResultsCounter = promauto.NewCounterVec(prometheus.CounterOpts{
+		Namespace: metricsNamespace,
+		Name:      "stage_results_total",
+		Help:      "Stages indexed by stage and result",
+	}, []string{"stage", "result"})
+	retriesCounter = promauto.NewCounterVec(prometheus.CounterOpts{
+		Namespace: metricsNamespace,
+		Name:      "retries_total",
+		Help:      "Retries of indexing calls by stage",
+	}, []string{"stage"})
+	fallbacksCounter = promauto.NewCounterVec(prometheus.CounterOpts{
+		Namespace: metricsNamespace,
+		Name:      "fallbacks_total",
+		Help:      "Stages that fell back to the fallback node by stage",
+	}, []string{"stage"})
+	deadLettersCounter = promauto.NewCounterVec(prometheus.CounterOpts{
+		Namespace: metricsNamespace,
+		Name:      "dead_letters_total",
+		Help:      "Failed heights that ran out of attempts by stage",
+	}, []string{"stage"})
+	leasesClaimedCounter = promauto.NewCounter(prometheus.CounterOpts{
+		Namespace: metricsNamespace,
+		Name:      "height_leases_claimed_total",
+		Help:      "Height ranges leased to this replica, reclaimed ones included",
+	})
+	leasesCompletedCounter = promauto.NewCounter(prometheus.CounterOpts{
+		Namespace: metricsNamespace,
+		Name:      "height_leases_completed_total",
+		Help:      "Height ranges completed by this replica",
+	})
+	leasesLostCounter = promauto.NewCounter(prometheus.CounterOpts{
+		Namespace: metricsNamespace,
+		Name:      "height_leases_lost_total",
+		Help:      "Height ranges reclaimed by another replica while this one held them",
+	})
+	leaderGauge = promauto.NewGauge(prometheus.GaugeOpts{
+		Namespace: metricsNamespace,
+		Name:      "leader",
+		Help:      "Whether this replica holds the leader lock and follows the tip",
+	})
+	semaphoreInUseGauge = promauto.NewGauge(prometheus.GaugeOpts{
+		Namespace: metricsNamespace,
+		Name:      "semaphore_in_use",
+		Help:      "Units of the concurrency semaphore currently acquired",
+	})
+	semaphoreLimitGauge = promauto.NewGauge(prometheus.GaugeOpts{
+		Namespace: metricsNamespace,
+		Name:      "semaphore_limit",
+		Help:      "Units of the concurrency semaphore",
+	})
+	concurrencyAdjustmentsCounter = promauto.NewCounterVec(prometheus.CounterOpts{
+		Namespace: metricsNamespace,
+		Name:      "concurrency_adjustments_total",
+		Help:      "Changes of the semaphore limit made by the adaptive concurrency by direction",
+	}, []string{"direction"})
+	accountQueueGauge = promauto.NewGauge(prometheus.GaugeOpts{
+		Namespace: metricsNamespace,
+		Name:      "account_queue_length",
+		Help:      "Accounts queued waiting for an account worker",
+	})
+	accountsDeduplicatedCounter = promauto.NewCounter(prometheus.CounterOpts{
+		Namespace: metricsNamespace,
+		Name:      "accounts_deduplicated_total",
+		Help:      "Accounts not queued since they were already queued or running at the height",
+	})
+	nodeScoreGauge = promauto.NewGaugeVec(prometheus.GaugeOpts{
+		Namespace: metricsNamespace,
+		Name:      "node_score",
+		Help:      "Health score of the node used to weight its share of calls",
+	}, []string{"node"})
+	nodeCircuitOpenGauge = promauto.NewGaugeVec(prometheus.GaugeOpts{
+		Namespace: metricsNamespace,
+		Name:      "node_circuit_open",
+		Help:      "Whether the circuit of the node is open after consecutive failures",
+	}, []string{"node"})
+	nodeRateLimitGauge = promauto.NewGaugeVec(prometheus.GaugeOpts{
+		Namespace: metricsNamespace,
+		Name:      "node_rate_limit",
+		Help:      "Requests per second allowed to the node, 0 when it is not limited",
+	}, []string{"node"})
+	rateLimitWaitHistogram = promauto.NewHistogramVec(prometheus.HistogramOpts{
+		Namespace: metricsNamespace,
+		Name:      "rate_limit_wait_seconds",
+		Help:      "Time the provider calls waited for the rate limit of the node",
+		Buckets:   prometheus.ExponentialBuckets(0.001, 2, 14),
+	}, []string{"node"})
+	providerDurationHistogram = promauto.NewHistogramVec(prometheus.HistogramOpts{
+		Namespace: metricsNamespace,
+		Name:      "provider_request_duration_seconds",
+		Help:      "Latency of the provider calls by node, method and result",
+		Buckets:   prometheus.ExponentialBuckets(0.01, 2, 14),
+	}, []string{"node", "method", "result"})
+	driverDurationHistogram = promauto.NewHistogramVec(prometheus.HistogramOpts{
+		Namespace: metricsNamespace,
+		Name:      "driver_request_duration_seconds",
+		Help:      "Latency of the driver calls by method and result",
+		Buckets:   prometheus.ExponentialBuckets(0.001, 2, 14),
+	}, []string{"method", "result"})
+)
+
+func getResult(err error) string {
+	if err != nil {
+		return resultFailure
+	}
+
+	return resultSuccess
+}
+
+func observeStageResult(stage string, err error) {
+	stageResultsCounter.WithLabelValues(stage, getResult(err)).Inc()
+}
+
+func setHeightsMetrics(indexedHeight, chainHeight int) {
+	indexedHeightGauge.Set(float64(indexedHeight))
+	chainHeightGauge.Set(float64(chainHeight))
+	lagGauge.Set(float64(chainHeight - indexedHeight))
+}
+
+// instrumentedProvider measures the latency of the provider calls made while indexing
+type instrumentedProvider struct {
+	provider
+	node string
+}
+
+func newInstrumentedProvider(provider provider, node string) *instrumentedProvider {
+	return &instrumentedProvider{
+		provider: provider,
+		node:     node,
+	}
+}
+
+func (p *instrumentedProvider) observe(method string, start time.Time, err error) {
+	providerDurationHistogram.WithLabelValues(p.node, method, getResult(err)).Observe(time.Since(start).Seconds())
+}
+
+func (p *instrumentedProvider) GetBlock(blockNumber int) (*providerlib.GetBlockOutput, error) {
+	start := time.Now()
+	output, err := p.provider.GetBlock(blockNumber)
+	p.observe("GetBlock", start, err)
+
+	return output, err
+}
+
+func (p *instrumentedProvider) GetBlockTransactions(options *providerlib.GetBlockTransactionsOptions) (*providerlib.GetBlockTransactionsOutput, error) {
+	start := time.Now()
+	output, err := p.provider.GetBlockTransactions(options)
+	p.observe("GetBlockTransactions", start, err)
+
+	return output, err
+}
+
+func (p *instrumentedProvider) GetAccount(address string, options *providerlib.GetAccountOptions) (*providerlib.GetAccountOutput, error) {
+	start := time.Now()
+	output, err := p.provider.GetAccount(address, options)
+	p.observe("GetAccount", start, err)
+
+	return output, err
+}
+
+func (p *instrumentedProvider) GetNodes(options *providerlib.GetNodesOptions) (*providerlib.GetNodesOutput, error) {
+	start := time.Now()
+	output, err := p.provider.GetNodes(options)
+	p.observe("GetNodes", start, err)
+
+	return output, err
+}
+
+func (p *instrumentedProvider) GetApps(options *providerlib.GetAppsOptions) (*providerlib.GetAppsOutput, error) {
+	start := time.Now()
+	output, err := p.provider.GetApps(options)
+	p.observe("GetApps", start, err)
+
+	return output, err
+}
+
+func (p *instrumentedProvider) GetBlockHeight() (int, error) {
+	start := time.Now()
+	height, err := p.provider.GetBlockHeight()
+	p.observe("GetBlockHeight", start, err)
+
+	return height, err
+}
+
+// instrumentedDriver measures the latency of the driver writes and of the height lookups
+type instrumentedDriver struct {
+	driver
+}
+
+func newInstrumentedDriver(driver driver) *instrumentedDriver {
+	return &instrumentedDriver{
+		driver: driver,
+	}
+}
+
+func observeDriver(method string, start time.Time, err error) {
+	driverDurationHistogram.WithLabelValues(method, getResult(err)).Observe(time.Since(start).Seconds())
+}
+
+func (d *instrumentedDriver) GetMaxHeightInBlocks() (int64, error) {
+	start := time.Now()
+	height, err := d.driver.GetMaxHeightInBlocks()
+	observeDriver("GetMaxHeightInBlocks", start, err)
+
+	return height, err
+}
+
+func (d *instrumentedDriver) WriteBlock(block *indexerlib.Block) error {
+	start := time.Now()
+	err := d.driver.WriteBlock(block)
+	observeDriver("WriteBlock", start, err)
+
+	return err
+}
+
+func (d *instrumentedDriver) WriteTransactions(txs []*indexerlib.Transaction) error {
+	start := time.Now()
+	err := d.driver.WriteTransactions(txs)
+	observeDriver("WriteTransactions", start, err)
+
+	return err
+}
+
+func (d *instrumentedDriver) WriteAccount(account *indexerlib.Account) error {
+	start := time.Now()
+	err := d.driver.WriteAccount(account)
+	observeDriver("WriteAccount", start, err)
+
+	return err
+}
+
+func (d *instrumentedDriver) WriteNodes(nodes []*indexerlib.Node) error {
+	start := time.Now()
+	err := d.driver.WriteNodes(nodes)
+	observeDriver("WriteNodes", start, err)
+
+	return err
+}
+
+func (d *instrumentedDriver) WriteApps(apps []*indexerlib.App) error {
+	start := time.Now()
+	err := d.driver.WriteApps(apps)
+	observeDriver("WriteApps", start, err)
+
+	return err
+}
+
+func (d *instrumentedDriver) ReadBlockByHeight(height int) (*indexerlib.Block, error) {
+	start := time.Now()
+	block, err := d.driver.ReadBlockByHeight(height)
+	observeDriver("ReadBlockByHeight", start, err)
+
+	return block, err
+}
@@ -0,0 +1,356 @@
+package main
+
+import (
+	"context"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/pokt-foundation/pocket-indexer-services/storage"
+)
+
+var (
+	// fanOutStages are the stages whose accounts are indexed by the accounts stage,
+	// the accounts of a height are not complete until these stages are
+	fanOutStages = map[string]bool{storage.StageTransactions: true, storage.StageNodes: true, storage.StageApps: true}
+)
+
+type stageHeight struct {
+	height int
+	stage  string
+}
+
+// heightTracker keeps the heights queued or with indexing processes still running,
+// the ones whose processes were aborted by a shutdown and the committed height of each tracked stage,
+// the highest height every lower height was indexed through by the stage
+// A stage is not complete at a height while it has processes running or failures not re-driven yet
+type heightTracker struct {
+	mu           sync.Mutex
+	tracked      []string
+	pending      map[int]int
+	aborted      map[int]bool
+	stagePending map[stageHeight]int
+	stageFailed  map[stageHeight]map[string]bool
+	completed    map[stageHeight]bool
+	committed    map[string]int
+}
+
+func newHeightTracker() *heightTracker {
+	return &heightTracker{
+		pending:      make(map[int]int),
+		aborted:      make(map[int]bool),
+		stagePending: make(map[stageHeight]int),
+		stageFailed:  make(map[stageHeight]map[string]bool),
+		completed:    make(map[stageHeight]bool),
+		committed:    make(map[string]int),
+	}
+}
+
+// track sets the stages with their own committed height, the disabled stages are not tracked
+func (t *heightTracker) track(stages []string) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	t.tracked = stages
+}
+
+// queue marks the height as queued until it is launched or cancelled
+func (t *heightTracker) queue(height int) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	t.pending[height]++
+}
+
+// cancel releases the queued mark of a height that will not be launched
+func (t *heightTracker) cancel(height int) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	t.aborted[height] = true
+	t.release(height, 1)
+}
+
+// launch adds a process for each stage launched and releases the queued mark of the height,
+// the stages not launched are complete at the height unless they failed there
+func (t *heightTracker) launch(height int, stages []string) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	for _, stage := range stages {
+		t.addStage(height, stage, 1)
+	}
+
+	for _, stage := range t.tracked {
+		t.settle(height, stage)
+	}
+
+	t.release(height, 1)
+}
+
+// add adds processes of the stage to the height
+func (t *heightTracker) add(height int, stage string, processes int) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	t.addStage(height, stage, processes)
+}
+
+func (t *heightTracker) done(height int, stage string) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	t.release(height, 1)
+
+	for _, blockedStage := range t.getBlockedStages(stage) {
+		key := stageHeight{height: height, stage: blockedStage}
+
+		t.stagePending[key]--
+		if t.stagePending[key] <= 0 {
+			delete(t.stagePending, key)
+		}
+
+		t.settle(height, blockedStage)
+	}
+}
+
+// fail keeps the stage from completing at the height until the failure is recovered
+func (t *heightTracker) fail(height int, stage, address string) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	for _, blockedStage := range t.getBlockedStages(stage) {
+		if height <= t.committed[blockedStage] {
+			continue
+		}
+
+		key := stageHeight{height: height, stage: blockedStage}
+
+		if t.stageFailed[key] == nil {
+			t.stageFailed[key] = make(map[string]bool)
+		}
+
+		t.stageFailed[key][stage+"/"+address] = true
+	}
+}
+
+// recover removes a failure of the stage at the height once it was re-driven successfully
+func (t *heightTracker) recover(height int, stage, address string) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	for _, blockedStage := range t.getBlockedStages(stage) {
+		key := stageHeight{height: height, stage: blockedStage}
+
+		delete(t.stageFailed[key], stage+"/"+address)
+
+		t.settle(height, blockedStage)
+	}
+}
+
+func (t *heightTracker) abort(height int) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	t.aborted[height] = true
+}
+
+func (t *heightTracker) addStage(height int, stage string, processes int) {
+	t.pending[height] += processes
+
+	for _, blockedStage := range t.getBlockedStages(stage) {
+		t.stagePending[stageHeight{height: height, stage: blockedStage}] += processes
+	}
+}
+
+func (t *heightTracker) release(height, processes int) {
+	t.pending[height] -= processes
+	if t.pending[height] <= 0 {
+		delete(t.pending, height)
+	}
+}
+
+// settle completes the stage at the height when nothing is running or failed for it there,
+// moving its committed height through the contiguous completed heights
+func (t *heightTracker) settle(height int, stage string) {
+	key := stageHeight{height: height, stage: stage}
+
+	if t.stagePending[key] > 0 || len(t.stageFailed[key]) > 0 {
+		return
+	}
+
+	delete(t.stageFailed, key)
+
+	if height <= t.committed[stage] {
+		return
+	}
+
+	t.completed[key] = true
+
+	for {
+		next := stageHeight{height: t.committed[stage] + 1, stage: stage}
+		if !t.completed[next] {
+			break
+		}
+
+		delete(t.completed, next)
+		t.committed[stage]++
+	}
+
+	committedHeightGauge.WithLabelValues(stage).Set(float64(t.committed[stage]))
+}
+
+// getBlockedStages returns the tracked stages that can't complete at a height while the stage is running or failed there
+func (t *heightTracker) getBlockedStages(stage string) []string {
+	blockedStages := []string{stage}
+	if fanOutStages[stage] {
+		blockedStages = append(blockedStages, storage.StageAccounts)
+	}
+
+	var trackedStages []string
+
+	for _, blockedStage := range blockedStages {
+		if containsStage(t.tracked, blockedStage) {
+			trackedStages = append(trackedStages, blockedStage)
+		}
+	}
+
+	return trackedStages
+}
+
+func (t *heightTracker) isScheduled(height int) bool {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	_, ok := t.pending[height]
+
+	return ok
+}
+
+// isRangeScheduled reports whether any height of the range is still queued or running
+func (t *heightTracker) isRangeScheduled(fromHeight, toHeight int) bool {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	for height := fromHeight; height <= toHeight; height++ {
+		if _, ok := t.pending[height]; ok {
+			return true
+		}
+	}
+
+	return false
+}
+
+// isRangeAborted reports whether the processes of any height of the range were aborted by a shutdown
+func (t *heightTracker) isRangeAborted(fromHeight, toHeight int) bool {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	for height := fromHeight; height <= toHeight; height++ {
+		if t.aborted[height] {
+			return true
+		}
+	}
+
+	return false
+}
+
+func (t *heightTracker) setCommitted(stage string, height int) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	t.committed[stage] = height
+
+	committedHeightGauge.WithLabelValues(stage).Set(float64(height))
+}
+
+// getCommittedHeights returns the committed height of each tracked stage
+func (t *heightTracker) getCommittedHeights() map[string]int {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	committedHeights := make(map[string]int, len(t.tracked))
+
+	for _, stage := range t.tracked {
+		committedHeights[stage] = t.committed[stage]
+	}
+
+	return committedHeights
+}
+
+// getCommitted returns the height every stage was indexed through
+func (t *heightTracker) getCommitted() int {
+	return getMinCommitted(t.getCommittedHeights())
+}
+
+// incomplete returns sorted the heights still queued, running or aborted
+func (t *heightTracker) incomplete() []int {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	var heights []int
+
+	for height := range t.pending {
+		heights = append(heights, height)
+	}
+
+	for height := range t.aborted {
+		if _, ok := t.pending[height]; !ok {
+			heights = append(heights, height)
+		}
+	}
+
+	sort.Ints(heights)
+
+	return heights
+}
+
+func getMinCommitted(committedHeights map[string]int) int {
+	minCommitted := -1
+
+	for _, committedHeight := range committedHeights {
+		if minCommitted < 0 || committedHeight < minCommitted {
+			minCommitted = committedHeight
+		}
+	}
+
+	return minCommitted
+}
+
+func (s *service) isFollowing() bool {
+	return s.mode == modeFollow
+}
+
+// checkpointCommittedHeights periodically saves the committed height of each stage so a restart resumes from them
+func (s *service) checkpointCommittedHeights(ctx context.Context) {
+	defer s.backgroundProcesses.Done()
+
+	lastCheckpoints := s.heightsInFlight.getCommittedHeights()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-time.After(s.checkpointInterval):
+		}
+
+		s.writeCommittedHeights(lastCheckpoints)
+	}
+}
+
+// writeCommittedHeights saves the committed heights that moved from the last checkpoints, updating them
+func (s *service) writeCommittedHeights(lastCheckpoints map[string]int) {
+	for stage, committedHeight := range s.heightsInFlight.getCommittedHeights() {
+		if lastCheckpoint, ok := lastCheckpoints[stage]; ok && lastCheckpoint == committedHeight {
+			continue
+		}
+
+		err := s.driver.WriteProgress(stage, committedHeight)
+		if err != nil {
+			s.logErrorWithFields("Save committed height failed", committedHeight, err)
+			continue
+		}
+
+		lastCheckpoints[stage] = committedHeight
+	}
+}
@@ -0,0 +1,180 @@
+package main
+
+import (
+	"testing"
+
+	"github.com/pokt-foundation/pocket-indexer-services/storage"
+	"github.com/stretchr/testify/require"
+)
+
+func TestHeightTracker_Settle(t *testing.T) {
+	block, transactions, accounts := storage.StageBlock, storage.StageTransactions, storage.StageAccounts
+
+	tests := []struct {
+		name      string
+		tracked   []string
+		run       func(tracker *heightTracker)
+		committed map[string]int
+	}{
+		{
+			name:    "heights done out of order",
+			tracked: []string{block},
+			run: func(tracker *heightTracker) {
+				for height := 1; height <= 3; height++ {
+					tracker.queue(height)
+					tracker.launch(height, []string{block})
+				}
+
+				tracker.done(3, block)
+				tracker.done(2, block)
+			},
+			committed: map[string]int{block: 0},
+		},
+		{
+			name:    "contiguous heights done",
+			tracked: []string{block},
+			run: func(tracker *heightTracker) {
+				for height := 1; height <= 3; height++ {
+					tracker.queue(height)
+					tracker.launch(height, []string{block})
+				}
+
+				tracker.done(3, block)
+				tracker.done(2, block)
+				tracker.done(1, block)
+			},
+			committed: map[string]int{block: 3},
+		},
+		{
+			name:    "failure holds the committed height",
+			tracked: []string{block},
+			run: func(tracker *heightTracker) {
+				tracker.queue(1)
+				tracker.launch(1, []string{block})
+				tracker.fail(1, block, "")
+				tracker.done(1, block)
+			},
+			committed: map[string]int{block: 0},
+		},
+		{
+			name:    "recovered failure",
+			tracked: []string{block},
+			run: func(tracker *heightTracker) {
+				tracker.queue(1)
+				tracker.launch(1, []string{block})
+				tracker.fail(1, block, "")
+				tracker.done(1, block)
+				tracker.recover(1, block, "")
+			},
+			committed: map[string]int{block: 1},
+		},
+		{
+			name:    "stages not launched complete",
+			tracked: []string{block, transactions},
+			run: func(tracker *heightTracker) {
+				tracker.queue(1)
+				tracker.launch(1, []string{block})
+			},
+			committed: map[string]int{block: 0, transactions: 1},
+		},
+		{
+			name:    "accounts wait for the fan-out stage and its accounts",
+			tracked: []string{transactions, accounts},
+			run: func(tracker *heightTracker) {
+				tracker.queue(1)
+				tracker.launch(1, []string{transactions})
+				tracker.add(1, accounts, 1)
+				tracker.done(1, transactions)
+			},
+			committed: map[string]int{transactions: 1, accounts: 0},
+		},
+		{
+			name:    "accounts done after the fan-out stage",
+			tracked: []string{transactions, accounts},
+			run: func(tracker *heightTracker) {
+				tracker.queue(1)
+				tracker.launch(1, []string{transactions})
+				tracker.add(1, accounts, 1)
+				tracker.done(1, transactions)
+				tracker.done(1, accounts)
+			},
+			committed: map[string]int{transactions: 1, accounts: 1},
+		},
+		{
+			name:    "failed account holds only the accounts stage",
+			tracked: []string{transactions, accounts},
+			run: func(tracker *heightTracker) {
+				tracker.queue(1)
+				tracker.launch(1, []string{transactions})
+				tracker.add(1, accounts, 1)
+				tracker.done(1, transactions)
+				tracker.fail(1, accounts, "address")
+				tracker.done(1, accounts)
+			},
+			committed: map[string]int{transactions: 1, accounts: 0},
+		},
+		{
+			name:    "stage without fan-out does not block the accounts",
+			tracked: []string{accounts},
+			run: func(tracker *heightTracker) {
+				tracker.queue(1)
+				tracker.launch(1, []string{block})
+			},
+			committed: map[string]int{accounts: 1},
+		},
+		{
+			name:    "failure under the committed height is ignored",
+			tracked: []string{block},
+			run: func(tracker *heightTracker) {
+				tracker.setCommitted(block, 5)
+				tracker.fail(3, block, "")
+				tracker.queue(6)
+				tracker.launch(6, []string{block})
+				tracker.done(6, block)
+			},
+			committed: map[string]int{block: 6},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tracker := newHeightTracker()
+			tracker.track(tt.tracked)
+
+			tt.run(tracker)
+
+			require.Equal(t, tt.committed, tracker.getCommittedHeights())
+		})
+	}
+}
+
+func TestHeightTracker_Incomplete(t *testing.T) {
+	c := require.New(t)
+
+	tracker := newHeightTracker()
+	tracker.track([]string{storage.StageBlock})
+
+	tracker.queue(3)
+	tracker.queue(1)
+	tracker.launch(1, []string{storage.StageBlock})
+	tracker.queue(2)
+	tracker.cancel(2)
+
+	c.True(tracker.isScheduled(1))
+	c.False(tracker.isScheduled(2))
+	c.True(tracker.isRangeScheduled(2, 3))
+	c.True(tracker.isRangeAborted(1, 2))
+	c.False(tracker.isRangeAborted(3, 4))
+	c.Equal([]int{1, 2, 3}, tracker.incomplete())
+
+	tracker.done(1, storage.StageBlock)
+
+	c.Equal([]int{2, 3}, tracker.incomplete())
+}
+
+func TestGetMinCommitted(t *testing.T) {
+	c := require.New(t)
+
+	c.Equal(-1, getMinCommitted(nil))
+	c.Equal(4, getMinCommitted(map[string]int{storage.StageBlock: 7, storage.StageAccounts: 4}))
+}
@@ -0,0 +1,452 @@
+package main
+
+import (
+	"context"
+	"errors"
+	"math/rand"
+	"strings"
+	"sync"
+	"time"
+
+	providerlib "github.com/pokt-foundation/pocket-go/provider"
+	"github.com/pokt-foundation/pocket-indexer-services/config"
+	"github.com/sirupsen/logrus"
+)
+
+const (
+	// nodeScoreSmoothing is the weight of the last call in the latency and error rate moving averages
+	nodeScoreSmoothing = 0.2
+	// nodeReferenceLatency is the latency that halves the score of a node
+	nodeReferenceLatency = time.Second
+	// nodeMinScore keeps nodes with bad scores receiving some traffic so they can recover
+	nodeMinScore = 0.01
+)
+
+var errNoNodes = errors.New("no nodes to index from")
+
+// nodeError is an error of a call routed by the pool, keeping the node that returned it
+type nodeError struct {
+	node string
+	err  error
+}
+
+func (e *nodeError) Error() string {
+	return e.err.Error()
+}
+
+func (e *nodeError) Unwrap() error {
+	return e.err
+}
+
+// getErrorNode returns the node that returned the error, empty if it did not come from the pool
+func getErrorNode(err error) string {
+	var errWithNode *nodeError
+
+	if errors.As(err, &errWithNode) {
+		return errWithNode.node
+	}
+
+	return ""
+}
+
+// poolNode struct handler for a node in the pool with its health state
+// the probes of the pool go straight to the provider, only the indexing calls take tokens from the bucket
+type poolNode struct {
+	url      string
+	provider provider
+	bucket   *tokenBucket
+
+	mu                  sync.Mutex
+	latency             time.Duration
+	errorRate           float64
+	height              int
+	consecutiveFailures int
+	openUntil           time.Time
+	halfOpenTrial       bool
+}
+
+// providerPool routes provider calls across nodes weighted by their health score,
+// opening the circuit of a node after consecutive failures
+// the calls are observed by the concurrency controller when there is one
+type providerPool struct {
+	nodes            []*poolNode
+	failureThreshold int
+	openDuration     time.Duration
+	controller       *concurrencyController
+}
+
+// providerPoolView is the provider interface of the pool, preferBest routes every call
+// to the best scored node instead of weighting the choice
+// the calls of the view are given up once ctx is cancelled
+type providerPoolView struct {
+	ctx        context.Context
+	pool       *providerPool
+	preferBest bool
+}
+
+// callResult is the output of a call routed by the pool
+type callResult[T any] struct {
+	output T
+	err    error
+}
+
+func getNodeURLs(mainNode, fallbackNode string, nodes []string) []string {
+	var urls []string
+	seen := make(map[string]bool)
+
+	for _, url := range append([]string{mainNode, fallbackNode}, nodes...) {
+		url = strings.TrimSpace(url)
+		if url == "" || seen[url] {
+			continue
+		}
+
+		seen[url] = true
+		urls = append(urls, url)
+	}
+
+	return urls
+}
+
+func newProviderPool(urls []string, options config.NodePool) (*providerPool, error) {
+	if len(urls) == 0 {
+		return nil, errNoNodes
+	}
+
+	pool := &providerPool{
+		failureThreshold: int(options.FailureThreshold),
+		openDuration:     milliseconds(options.OpenDuration),
+	}
+
+	for _, url := range urls {
+		pool.nodes = append(pool.nodes, &poolNode{
+			url:      url,
+			provider: newInstrumentedProvider(providerlib.NewProvider(url, nil), url),
+			bucket:   newNodeBucket(url, getRateLimit(options, url)),
+		})
+	}
+
+	return pool, nil
+}
+
+func (p *providerPool) view(ctx context.Context, preferBest bool) *providerPoolView {
+	return &providerPoolView{
+		ctx:        ctx,
+		pool:       p,
+		preferBest: preferBest,
+	}
+}
+
+func (p *providerPool) maxHeight() int {
+	var maxHeight int
+
+	for _, node := range p.nodes {
+		node.mu.Lock()
+		if node.height > maxHeight {
+			maxHeight = node.height
+		}
+		node.mu.Unlock()
+	}
+
+	return maxHeight
+}
+
+// pick returns the node for the next call, when every circuit is open
+// the node whose circuit closes first is returned
+func (p *providerPool) pick(preferBest bool) *poolNode {
+	maxHeight := p.maxHeight()
+
+	var candidates []*poolNode
+	var scores []float64
+	var totalScore float64
+
+	for _, node := range p.nodes {
+		score, available := node.available(maxHeight)
+		if !available {
+			continue
+		}
+
+		candidates = append(candidates, node)
+		scores = append(scores, score)
+		totalScore += score
+	}
+
+	if len(candidates) == 0 {
+		return p.firstToClose()
+	}
+
+	chosen := candidates[weightedIndex(scores, totalScore)]
+	if preferBest {
+		chosen = candidates[indexOfMax(scores)]
+	}
+
+	chosen.startCall()
+
+	return chosen
+}
+
+func (p *providerPool) firstToClose() *poolNode {
+	first := p.nodes[0]
+
+	for _, node := range p.nodes[1:] {
+		if node.getOpenUntil().Before(first.getOpenUntil()) {
+			first = node
+		}
+	}
+
+	return first
+}
+
+// callPool routes the operation to a node of the pool, once ctx is cancelled the call is given up right away
+// the providers take no context so the operation is left to finish in the background, still recorded in the node health
+func callPool[T any](ctx context.Context, p *providerPool, preferBest bool, operation func(node *poolNode) (T, error)) (T, error) {
+	var output T
+
+	if ctx.Err() != nil {
+		return output, ctx.Err()
+	}
+
+	node := p.pick(preferBest)
+
+	err := node.wait(ctx)
+	if err != nil {
+		node.cancelCall()
+		return output, err
+	}
+
+	results := make(chan callResult[T], 1)
+
+	go func() {
+		start := time.Now()
+		output, err := operation(node)
+		latency := time.Since(start)
+
+		node.record(latency, err, p.failureThreshold, p.openDuration)
+
+		if p.controller != nil {
+			p.controller.observe(latency, err)
+		}
+
+		results <- callResult[T]{output: output, err: err}
+	}()
+
+	select {
+	case result := <-results:
+		if result.err != nil {
+			return result.output, &nodeError{node: node.url, err: result.err}
+		}
+
+		return result.output, nil
+	case <-ctx.Done():
+		return output, ctx.Err()
+	}
+}
+
+// probe refreshes the height, latency and error rate of every node until ctx is cancelled
+func (p *providerPool) probe(ctx context.Context, interval time.Duration) {
+	for {
+		for _, node := range p.nodes {
+			start := time.Now()
+			height, err := node.provider.GetBlockHeight()
+			node.record(time.Since(start), err, p.failureThreshold, p.openDuration)
+			node.setHeight(height, err)
+		}
+
+		p.setScoreMetrics()
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-time.After(interval):
+		}
+	}
+}
+
+func (p *providerPool) setScoreMetrics() {
+	maxHeight := p.maxHeight()
+
+	for _, node := range p.nodes {
+		node.mu.Lock()
+		nodeScoreGauge.WithLabelValues(node.url).Set(node.score(maxHeight))
+		nodeCircuitOpenGauge.WithLabelValues(node.url).Set(boolToFloat(node.isOpen()))
+		node.mu.Unlock()
+	}
+}
+
+func (p *providerPool) updateRequestConfig(retries int, timeout time.Duration) {
+	for _, node := range p.nodes {
+		node.provider.UpdateRequestConfig(retries, timeout)
+	}
+}
+
+// score must be called with the node locked
+func (n *poolNode) score(maxHeight int) float64 {
+	lag := maxHeight - n.height
+	if lag < 0 {
+		lag = 0
+	}
+
+	score := (1 - n.errorRate) / (1 + float64(n.latency)/float64(nodeReferenceLatency)) / float64(1+lag)
+	if score < nodeMinScore {
+		return nodeMinScore
+	}
+
+	return score
+}
+
+// isOpen must be called with the node locked
+func (n *poolNode) isOpen() bool {
+	return n.consecutiveFailures > 0 && !n.openUntil.IsZero()
+}
+
+// available returns the score of the node and whether it can take a call, a node whose open circuit
+// expired takes a single trial call before closing or opening again
+func (n *poolNode) available(maxHeight int) (float64, bool) {
+	n.mu.Lock()
+	defer n.mu.Unlock()
+
+	if n.isOpen() && (time.Now().Before(n.openUntil) || n.halfOpenTrial) {
+		return 0, false
+	}
+
+	return n.score(maxHeight), true
+}
+
+// startCall marks the trial call of a node whose open circuit expired
+func (n *poolNode) startCall() {
+	n.mu.Lock()
+	defer n.mu.Unlock()
+
+	if n.isOpen() {
+		n.halfOpenTrial = true
+	}
+}
+
+// cancelCall releases the trial call of a node that was given up before it started
+func (n *poolNode) cancelCall() {
+	n.mu.Lock()
+	defer n.mu.Unlock()
+
+	n.halfOpenTrial = false
+}
+
+func (n *poolNode) getOpenUntil() time.Time {
+	n.mu.Lock()
+	defer n.mu.Unlock()
+
+	return n.openUntil
+}
+
+func (n *poolNode) record(latency time.Duration, err error, failureThreshold int, openDuration time.Duration) {
+	n.mu.Lock()
+	defer n.mu.Unlock()
+
+	n.latency = time.Duration(nodeScoreSmoothing*float64(latency) + (1-nodeScoreSmoothing)*float64(n.latency))
+	n.errorRate = nodeScoreSmoothing*boolToFloat(err != nil) + (1-nodeScoreSmoothing)*n.errorRate
+
+	wasTrial := n.halfOpenTrial
+	n.halfOpenTrial = false
+
+	if err == nil {
+		n.consecutiveFailures = 0
+		n.openUntil = time.Time{}
+		return
+	}
+
+	n.consecutiveFailures++
+
+	if wasTrial || n.consecutiveFailures == failureThreshold {
+		n.openUntil = time.Now().Add(openDuration)
+
+		log.WithFields(logrus.Fields{
+			"node": n.url,
+			"err":  err.Error(),
+		}).Warn("Node circuit opened")
+	}
+}
+
+func (n *poolNode) setHeight(height int, err error) {
+	if err != nil {
+		return
+	}
+
+	n.mu.Lock()
+	defer n.mu.Unlock()
+
+	n.height = height
+}
+
+func indexOfMax(values []float64) int {
+	maxIndex := 0
+
+	for i, value := range values {
+		if value > values[maxIndex] {
+			maxIndex = i
+		}
+	}
+
+	return maxIndex
+}
+
+func weightedIndex(weights []float64, totalWeight float64) int {
+	target := rand.Float64() * totalWeight
+
+	for i, weight := range weights {
+		target -= weight
+		if target < 0 {
+			return i
+		}
+	}
+
+	return len(weights) - 1
+}
+
+func boolToFloat(value bool) float64 {
+	if value {
+		return 1
+	}
+
+	return 0
+}
+
+func (v *providerPoolView) GetBlock(blockNumber int) (*providerlib.GetBlockOutput, error) {
+	return callPool(v.ctx, v.pool, v.preferBest, func(node *poolNode) (*providerlib.GetBlockOutput, error) {
+		return node.provider.GetBlock(blockNumber)
+	})
+}
+
+func (v *providerPoolView) GetBlockTransactions(options *providerlib.GetBlockTransactionsOptions) (*providerlib.GetBlockTransactionsOutput, error) {
+	return callPool(v.ctx, v.pool, v.preferBest, func(node *poolNode) (*providerlib.GetBlockTransactionsOutput, error) {
+		return node.provider.GetBlockTransactions(options)
+	})
+}
+
+func (v *providerPoolView) GetAccount(address string, options *providerlib.GetAccountOptions) (*providerlib.GetAccountOutput, error) {
+	return callPool(v.ctx, v.pool, v.preferBest, func(node *poolNode) (*providerlib.GetAccountOutput, error) {
+		return node.provider.GetAccount(address, options)
+	})
+}
+
+func (v *providerPoolView) GetNodes(options *providerlib.GetNodesOptions) (*providerlib.GetNodesOutput, error) {
+	return callPool(v.ctx, v.pool, v.preferBest, func(node *poolNode) (*providerlib.GetNodesOutput, error) {
+		return node.provider.GetNodes(options)
+	})
+}
+
+func (v *providerPoolView) GetApps(options *providerlib.GetAppsOptions) (*providerlib.GetAppsOutput, error) {
+	return callPool(v.ctx, v.pool, v.preferBest, func(node *poolNode) (*providerlib.GetAppsOutput, error) {
+		return node.provider.GetApps(options)
+	})
+}
+
+func (v *providerPoolView) GetBlockHeight() (int, error) {
+	return callPool(v.ctx, v.pool, v.preferBest, func(node *poolNode) (int, error) {
+		height, err := node.provider.GetBlockHeight()
+		node.setHeight(height, err)
+		return height, err
+	})
+}
+
+// UpdateRequestConfig updates retries and timeout of every node in the pool
+func (v *providerPoolView) UpdateRequestConfig(retries int, timeout time.Duration) {
+	v.pool.updateRequestConfig(retries, timeout)
+}
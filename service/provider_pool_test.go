@@ -0,0 +1,155 @@
+package main
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestCallPool(t *testing.T) {
+	c := require.New(t)
+
+	node := &poolNode{url: "https://node.example.com"}
+	pool := &providerPool{nodes: []*poolNode{node}, failureThreshold: 5, openDuration: time.Second}
+
+	height, err := callPool(context.Background(), pool, false, func(node *poolNode) (int, error) {
+		return 21, nil
+	})
+	c.NoError(err)
+	c.Equal(21, height)
+
+	_, err = callPool(context.Background(), pool, false, func(node *poolNode) (int, error) {
+		return 0, errDummy
+	})
+	c.ErrorIs(err, errDummy)
+	c.Equal("https://node.example.com", getErrorNode(err))
+}
+
+func TestCallPool_Cancelled(t *testing.T) {
+	c := require.New(t)
+
+	node := &poolNode{url: "https://node.example.com"}
+	pool := &providerPool{nodes: []*poolNode{node}, failureThreshold: 5, openDuration: time.Second}
+
+	ctx, cancel := context.WithCancel(context.Background())
+
+	release := make(chan struct{})
+	finished := make(chan struct{})
+
+	go func() {
+		time.Sleep(10 * time.Millisecond)
+		cancel()
+	}()
+
+	// The call in flight is given up without waiting for it
+	_, err := callPool(ctx, pool, false, func(node *poolNode) (int, error) {
+		defer close(finished)
+
+		<-release
+		return 0, errors.New("connection reset")
+	})
+	c.ErrorIs(err, context.Canceled)
+	c.Empty(getErrorNode(err))
+
+	close(release)
+	<-finished
+
+	// No call is started once ctx is cancelled
+	_, err = callPool(ctx, pool, false, func(node *poolNode) (int, error) {
+		c.Fail("call started after the cancellation")
+		return 0, nil
+	})
+	c.ErrorIs(err, context.Canceled)
+}
+
+func TestPoolNode_Score(t *testing.T) {
+	tests := []struct {
+		name      string
+		node      *poolNode
+		maxHeight int
+		score     float64
+	}{
+		{name: "healthy node", node: &poolNode{height: 10}, maxHeight: 10, score: 1},
+		{name: "reference latency", node: &poolNode{latency: nodeReferenceLatency, height: 10}, maxHeight: 10, score: 0.5},
+		{name: "half the calls failing", node: &poolNode{errorRate: 0.5, height: 10}, maxHeight: 10, score: 0.5},
+		{name: "lagging node", node: &poolNode{height: 7}, maxHeight: 10, score: 0.25},
+		{name: "node ahead", node: &poolNode{height: 12}, maxHeight: 10, score: 1},
+		{name: "floor score", node: &poolNode{errorRate: 1, height: 10}, maxHeight: 10, score: nodeMinScore},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			c := require.New(t)
+
+			c.InDelta(tt.score, tt.node.score(tt.maxHeight), 0.001)
+		})
+	}
+}
+
+func TestProviderPool_Pick(t *testing.T) {
+	c := require.New(t)
+
+	best := &poolNode{url: "https://best.example.com", height: 10}
+	lagging := &poolNode{url: "https://lagging.example.com", height: 1}
+	open := &poolNode{url: "https://open.example.com", height: 10, consecutiveFailures: 5, openUntil: time.Now().Add(time.Hour)}
+
+	pool := &providerPool{nodes: []*poolNode{lagging, open, best}}
+
+	// The node with an open circuit takes no calls
+	for i := 0; i < 20; i++ {
+		c.NotEqual(open, pool.pick(false))
+	}
+
+	c.Equal(best, pool.pick(true))
+
+	// With every circuit open the node closing first takes the call
+	closingFirst := &poolNode{url: "https://closing.example.com", consecutiveFailures: 5, openUntil: time.Now().Add(time.Minute)}
+	pool = &providerPool{nodes: []*poolNode{open, closingFirst}}
+
+	c.Equal(closingFirst, pool.pick(false))
+}
+
+func TestPoolNode_Circuit(t *testing.T) {
+	c := require.New(t)
+
+	node := &poolNode{url: "https://node.example.com"}
+
+	node.record(time.Millisecond, errDummy, 2, time.Hour)
+	c.False(node.isOpen())
+
+	node.record(time.Millisecond, errDummy, 2, time.Hour)
+	c.True(node.isOpen())
+
+	_, available := node.available(0)
+	c.False(available)
+
+	// Once the open duration passes a single trial call goes through
+	node.openUntil = time.Now().Add(-time.Second)
+
+	_, available = node.available(0)
+	c.True(available)
+
+	node.startCall()
+
+	_, available = node.available(0)
+	c.False(available)
+
+	// A failed trial opens the circuit again right away
+	node.record(time.Millisecond, errDummy, 2, time.Hour)
+	c.True(node.isOpen())
+	c.False(node.halfOpenTrial)
+	c.True(node.openUntil.After(time.Now()))
+
+	// A successful trial closes it
+	node.openUntil = time.Now().Add(-time.Second)
+	node.startCall()
+	node.record(time.Millisecond, nil, 2, time.Hour)
+
+	c.False(node.isOpen())
+
+	_, available = node.available(0)
+	c.True(available)
+}
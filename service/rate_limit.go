@@ -0,0 +1,109 @@
+package main
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"github.com/pokt-foundation/pocket-indexer-services/config"
+)
+
+// tokenBucket allows a number of requests per second with bursts up to its size,
+// requests over it take a token in advance and wait until it is refilled so they go through in order
+type tokenBucket struct {
+	mu     sync.Mutex
+	rate   float64
+	burst  float64
+	tokens float64
+	last   time.Time
+}
+
+func newTokenBucket(rateLimit config.RateLimit) *tokenBucket {
+	return &tokenBucket{
+		rate:   float64(rateLimit.RequestsPerSecond),
+		burst:  float64(rateLimit.Burst),
+		tokens: float64(rateLimit.Burst),
+		last:   time.Now(),
+	}
+}
+
+// reserve takes a token and returns how long to wait until it is available
+func (b *tokenBucket) reserve() time.Duration {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	now := time.Now()
+
+	b.tokens += now.Sub(b.last).Seconds() * b.rate
+	if b.tokens > b.burst {
+		b.tokens = b.burst
+	}
+
+	b.last = now
+	b.tokens--
+
+	if b.tokens >= 0 {
+		return 0
+	}
+
+	return time.Duration(-b.tokens / b.rate * float64(time.Second))
+}
+
+// refund gives back the token of a call that did not wait for it, so the calls behind it are not delayed
+func (b *tokenBucket) refund() {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	b.tokens++
+	if b.tokens > b.burst {
+		b.tokens = b.burst
+	}
+}
+
+// newNodeBucket returns the token bucket keeping the calls to a node under its requests per second,
+// the bucket is shared by every stage calling the node and is nil when the rate limit is disabled
+func newNodeBucket(node string, rateLimit config.RateLimit) *tokenBucket {
+	nodeRateLimitGauge.WithLabelValues(node).Set(float64(rateLimit.RequestsPerSecond))
+
+	if rateLimit.RequestsPerSecond <= 0 {
+		return nil
+	}
+
+	return newTokenBucket(rateLimit)
+}
+
+// getRateLimit returns the rate limit of the node, its own one if it has it
+func getRateLimit(options config.NodePool, node string) config.RateLimit {
+	if rateLimit, ok := options.NodeRateLimits[node]; ok {
+		return rateLimit
+	}
+
+	return options.RateLimit
+}
+
+// wait blocks until the node has a token for the next call, the wait is not part of the call latency
+// once ctx is cancelled the wait stops and its token is given back
+func (n *poolNode) wait(ctx context.Context) error {
+	if n.bucket == nil {
+		return nil
+	}
+
+	delay := n.bucket.reserve()
+
+	rateLimitWaitHistogram.WithLabelValues(n.url).Observe(delay.Seconds())
+
+	if delay <= 0 {
+		return nil
+	}
+
+	timer := time.NewTimer(delay)
+	defer timer.Stop()
+
+	select {
+	case <-ctx.Done():
+		n.bucket.refund()
+		return ctx.Err()
+	case <-timer.C:
+		return nil
+	}
+}
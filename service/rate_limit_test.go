@@ -0,0 +1,143 @@
+package main
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/pokt-foundation/pocket-indexer-services/config"
+	"github.com/stretchr/testify/require"
+)
+
+func TestTokenBucket_Reserve(t *testing.T) {
+	tests := []struct {
+		name     string
+		tokens   float64
+		elapsed  time.Duration
+		delay    time.Duration
+		leftover float64
+	}{
+		{name: "token available", tokens: 2, delay: 0, leftover: 1},
+		{name: "last token", tokens: 1, delay: 0, leftover: 0},
+		{name: "empty bucket", tokens: 0, delay: 100 * time.Millisecond, leftover: -1},
+		{name: "tokens taken in advance", tokens: -2, delay: 300 * time.Millisecond, leftover: -3},
+		{name: "refilled meanwhile", tokens: 0, elapsed: 200 * time.Millisecond, delay: 0, leftover: 1},
+		{name: "refill capped by the burst", tokens: 0, elapsed: time.Hour, delay: 0, leftover: 4},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			c := require.New(t)
+
+			bucket := newTokenBucket(config.RateLimit{RequestsPerSecond: 10, Burst: 5})
+			bucket.tokens = tt.tokens
+			bucket.last = time.Now().Add(-tt.elapsed)
+
+			delay := bucket.reserve()
+
+			c.InDelta(tt.delay, delay, float64(10*time.Millisecond))
+			c.InDelta(tt.leftover, bucket.tokens, 0.1)
+		})
+	}
+}
+
+func TestNewNodeBucket(t *testing.T) {
+	c := require.New(t)
+
+	c.Nil(newNodeBucket("https://disabled.example.com", config.RateLimit{}))
+
+	bucket := newNodeBucket("https://enabled.example.com", config.RateLimit{RequestsPerSecond: 5, Burst: 2})
+	c.NotNil(bucket)
+	c.Equal(float64(2), bucket.tokens)
+}
+
+func TestGetRateLimit(t *testing.T) {
+	c := require.New(t)
+
+	options := config.NodePool{
+		RateLimit: config.RateLimit{RequestsPerSecond: 10, Burst: 10},
+		NodeRateLimits: map[string]config.RateLimit{
+			"https://main.example.com": {RequestsPerSecond: 50, Burst: 20},
+		},
+	}
+
+	c.Equal(int64(50), getRateLimit(options, "https://main.example.com").RequestsPerSecond)
+	c.Equal(int64(10), getRateLimit(options, "https://other.example.com").RequestsPerSecond)
+}
+
+func TestProviderPool_CallExcludesRateLimitWait(t *testing.T) {
+	c := require.New(t)
+
+	node := &poolNode{
+		url:    "https://limited.example.com",
+		bucket: newTokenBucket(config.RateLimit{RequestsPerSecond: 10, Burst: 1}),
+	}
+	node.bucket.tokens = -1
+
+	pool := &providerPool{nodes: []*poolNode{node}, failureThreshold: 5, openDuration: time.Second}
+
+	start := time.Now()
+
+	_, err := callPool(context.Background(), pool, false, func(node *poolNode) (int, error) {
+		return 0, nil
+	})
+	c.NoError(err)
+
+	c.GreaterOrEqual(time.Since(start), 150*time.Millisecond)
+	c.Less(node.latency, 50*time.Millisecond)
+}
+
+func TestPoolNode_WaitCancelled(t *testing.T) {
+	c := require.New(t)
+
+	node := &poolNode{
+		url:    "https://limited.example.com",
+		bucket: newTokenBucket(config.RateLimit{RequestsPerSecond: 1, Burst: 1}),
+	}
+	node.bucket.tokens = -5
+
+	ctx, cancel := context.WithTimeout(context.Background(), 20*time.Millisecond)
+	defer cancel()
+
+	start := time.Now()
+
+	err := node.wait(ctx)
+	c.ErrorIs(err, context.DeadlineExceeded)
+	c.Less(time.Since(start), time.Second)
+
+	// The token taken in advance is given back so the calls behind are not delayed by it
+	c.InDelta(-5, node.bucket.tokens, 0.1)
+
+	// A refund never fills the bucket over its burst
+	node.bucket.tokens = 1
+	node.bucket.refund()
+	c.Equal(float64(1), node.bucket.tokens)
+}
+
+func TestCallPool_CancelledWhileWaiting(t *testing.T) {
+	c := require.New(t)
+
+	// The open circuit of the node expired so the call is its trial
+	node := &poolNode{
+		url:                 "https://limited.example.com",
+		bucket:              newTokenBucket(config.RateLimit{RequestsPerSecond: 1, Burst: 1}),
+		consecutiveFailures: 5,
+		openUntil:           time.Now().Add(-time.Second),
+	}
+	node.bucket.tokens = -5
+
+	pool := &providerPool{nodes: []*poolNode{node}, failureThreshold: 5, openDuration: time.Second}
+
+	ctx, cancel := context.WithTimeout(context.Background(), 20*time.Millisecond)
+	defer cancel()
+
+	_, err := callPool(ctx, pool, false, func(node *poolNode) (int, error) {
+		c.Fail("call started after the cancellation")
+		return 0, nil
+	})
+	c.ErrorIs(err, context.DeadlineExceeded)
+
+	// The trial given up is released so the node can take another one
+	_, available := node.available(0)
+	c.True(available)
+}
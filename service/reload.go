@@ -0,0 +1,48 @@
+package main
+
+import (
+	"context"
+	"sync/atomic"
+	"time"
+
+	"github.com/pokt-foundation/pocket-indexer-services/config"
+	"github.com/sirupsen/logrus"
+)
+
+func (s *service) getRequestInterval() time.Duration {
+	return time.Duration(atomic.LoadInt64(s.reqInterval))
+}
+
+// watchConfig reloads the config file every time it changes until ctx is cancelled
+func (s *service) watchConfig(ctx context.Context) {
+	defer s.backgroundProcesses.Done()
+
+	config.Watch(ctx, s.configPath, s.configReloadInterval, s.reloadConfig)
+}
+
+// reloadConfig applies the concurrency, or its adaptive bounds, and request interval of the reloaded configuration,
+// an invalid configuration is ignored so the service keeps running with the current one
+func (s *service) reloadConfig() {
+	reloadedConfig, _, err := loadValidConfig(s.loadConfig, s.mode)
+	if err != nil {
+		s.logErrorWithFields("Reload configuration failed", -1, err)
+		return
+	}
+
+	options := reloadedConfig.Service
+
+	if s.controller != nil {
+		s.controller.setBounds(options.AdaptiveConcurrency.MinConcurrency, options.AdaptiveConcurrency.MaxConcurrency)
+	} else {
+		s.semaphore.SetLimit(options.Concurrency)
+	}
+
+	atomic.StoreInt64(s.reqInterval, int64(milliseconds(options.RequestInterval)))
+
+	log.WithFields(logrus.Fields{
+		"concurrency":      options.Concurrency,
+		"min_concurrency":  options.AdaptiveConcurrency.MinConcurrency,
+		"max_concurrency":  options.AdaptiveConcurrency.MaxConcurrency,
+		"request_interval": options.RequestInterval,
+	}).Info("Configuration reloaded")
+}
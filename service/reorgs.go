@@ -0,0 +1,158 @@
+package main
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+
+	providerlib "github.com/pokt-foundation/pocket-go/provider"
+	"github.com/pokt-foundation/pocket-indexer-services/storage"
+	"github.com/sirupsen/logrus"
+)
+
+// checkRecentBlockHashes compares the saved blocks up to given height against the chain and re-indexes the ones that changed
+// Without the block stage the blocks are saved by another indexer, which checks them
+func (s *service) checkRecentBlockHashes(ctx context.Context, tasks chan<- *heightTask, toHeight int) {
+	if s.reorgCheckDepth <= 0 || !containsStage(s.trackedStages, storage.StageBlock) {
+		return
+	}
+
+	fromHeight := toHeight - s.reorgCheckDepth + 1
+	if fromHeight < 1 {
+		fromHeight = 1
+	}
+
+	err := s.verifyBlockHashes(ctx, tasks, fromHeight, toHeight)
+	if err != nil && !errors.Is(err, context.Canceled) {
+		s.logErrorWithFields("Check block hashes failed", -1, err)
+	}
+}
+
+// verifyBlockHashes finds the saved blocks in the range whose hash differs from the chain, records them and re-indexes them
+func (s *service) verifyBlockHashes(ctx context.Context, tasks chan<- *heightTask, fromHeight, toHeight int) error {
+	mismatches, err := s.findBlockHashMismatches(fromHeight, toHeight)
+	if err != nil {
+		return err
+	}
+
+	var mismatchedHeights []int
+
+	for _, mismatch := range mismatches {
+		err = s.driver.WriteBlockHashMismatch(mismatch)
+		if err != nil {
+			s.logErrorWithFields("Record block hash mismatch failed", mismatch.Height, err)
+		}
+
+		mismatchedHeights = append(mismatchedHeights, mismatch.Height)
+	}
+
+	return s.repairHeights(ctx, tasks, mismatchedHeights)
+}
+
+// findBlockHashMismatches logs the saved blocks in the range whose hash differs from the chain without writing anything,
+// so the verify command runs under a read-only role
+func (s *service) findBlockHashMismatches(fromHeight, toHeight int) ([]*storage.BlockHashMismatch, error) {
+	var mismatches []*storage.BlockHashMismatch
+
+	for height := fromHeight; height <= toHeight; height++ {
+		// Heights still queued or running are checked once they are complete
+		if s.heightsInFlight.isScheduled(height) {
+			continue
+		}
+
+		mismatch, err := s.getBlockHashMismatch(height)
+		if err != nil {
+			return nil, err
+		}
+
+		if mismatch == nil {
+			continue
+		}
+
+		s.logBlockHashMismatch(mismatch)
+
+		mismatches = append(mismatches, mismatch)
+	}
+
+	return mismatches, nil
+}
+
+// getBlockHashMismatch returns nil when the block is not saved or its hash matches the chain
+func (s *service) getBlockHashMismatch(height int) (*storage.BlockHashMismatch, error) {
+	storedBlock, err := s.driver.ReadBlockByHeight(height)
+	if err != nil {
+		// Missing blocks are left to the gap backfill
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, nil
+		}
+
+		return nil, err
+	}
+
+	canonicalBlock, err := s.getBlockWithFallback(height)
+	if err != nil {
+		return nil, err
+	}
+
+	if canonicalBlock.BlockID.Hash == storedBlock.Hash {
+		return nil, nil
+	}
+
+	return &storage.BlockHashMismatch{
+		Height:        height,
+		StoredHash:    storedBlock.Hash,
+		CanonicalHash: canonicalBlock.BlockID.Hash,
+	}, nil
+}
+
+func (s *service) getBlockWithFallback(height int) (*providerlib.GetBlockOutput, error) {
+	block, err := s.provider.GetBlock(height)
+	if err != nil {
+		return s.fallbackProvider.GetBlock(height)
+	}
+
+	return block, nil
+}
+
+// repairHeights deletes what the tracked stages saved at the heights and queues them to be indexed again
+func (s *service) repairHeights(ctx context.Context, tasks chan<- *heightTask, heights []int) error {
+	for _, height := range heights {
+		err := s.repairHeight(ctx, tasks, height)
+		if err != nil {
+			return err
+		}
+
+		s.logInfoWithFields("Block hash mismatch queued for re-indexing", "", height)
+	}
+
+	return nil
+}
+
+// repairHeight deletes what the tracked stages saved at the height and queues it to be indexed again
+func (s *service) repairHeight(ctx context.Context, tasks chan<- *heightTask, height int) error {
+	// Heights are not deleted when shutting down since they could not be indexed again
+	if ctx.Err() != nil {
+		return ctx.Err()
+	}
+
+	err := s.driver.DeleteHeight(height, s.trackedStages)
+	if err != nil {
+		return err
+	}
+
+	// A height deleted but not queued is found again by the gap sweep
+	if !s.sendTask(ctx, tasks, height, s.stages) {
+		return ctx.Err()
+	}
+
+	return nil
+}
+
+func (s *service) logBlockHashMismatch(mismatch *storage.BlockHashMismatch) {
+	log.WithFields(logrus.Fields{
+		"nodes":          s.nodes,
+		"height":         mismatch.Height,
+		"stored_hash":    mismatch.StoredHash,
+		"canonical_hash": mismatch.CanonicalHash,
+	}).Warn("Block hash mismatch detected")
+}
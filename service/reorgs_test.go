@@ -0,0 +1,69 @@
+package main
+
+import (
+	"context"
+	"testing"
+
+	indexerlib "github.com/pokt-foundation/pocket-indexer-lib"
+	"github.com/pokt-foundation/pocket-indexer-services/storage"
+	"github.com/stretchr/testify/require"
+)
+
+// newReorgedService returns a service whose block 2 was reorged out of the chain and block 3 is not saved
+func newReorgedService() (*service, *fakeDriver) {
+	driver := &fakeDriver{
+		blocks: map[int]*indexerlib.Block{
+			1: {Height: 1, Hash: "A1"},
+			2: {Height: 2, Hash: "B2"},
+		},
+	}
+
+	s := newTestService(&fakeIndexer{}, driver)
+	provider := &fakeProvider{hashes: map[int]string{1: "A1", 2: "C2", 3: "D3"}}
+	s.provider, s.fallbackProvider = provider, provider
+	s.fromHeight, s.toHeight = 1, 3
+
+	return s, driver
+}
+
+func TestService_RunVerify(t *testing.T) {
+	c := require.New(t)
+
+	s, driver := newReorgedService()
+
+	// The verify command runs under a read-only role so the mismatches are only reported
+	c.Equal(exitCodeFailure, s.runVerify())
+	c.Empty(driver.mismatches)
+
+	s.toHeight = 1
+
+	c.Equal(exitCodeSuccess, s.runVerify())
+}
+
+func TestService_FindBlockHashMismatches(t *testing.T) {
+	c := require.New(t)
+
+	s, driver := newReorgedService()
+
+	mismatches, err := s.findBlockHashMismatches(1, 3)
+	c.NoError(err)
+	c.Equal([]*storage.BlockHashMismatch{{Height: 2, StoredHash: "B2", CanonicalHash: "C2"}}, mismatches)
+
+	// Heights still in flight are left for the next check
+	s.heightsInFlight.queue(2)
+
+	mismatches, err = s.findBlockHashMismatches(1, 3)
+	c.NoError(err)
+	c.Empty(mismatches)
+	c.Empty(driver.mismatches)
+
+	// Following the tip records the mismatches before repairing them, a shutdown stops the repair
+	s.heightsInFlight = newHeightTracker()
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	err = s.verifyBlockHashes(ctx, make(chan *heightTask, 1), 1, 3)
+	c.ErrorIs(err, context.Canceled)
+	c.Equal([]*storage.BlockHashMismatch{{Height: 2, StoredHash: "B2", CanonicalHash: "C2"}}, driver.mismatches)
+}
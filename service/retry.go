@@ -0,0 +1,163 @@
+package main
+
+import (
+	"context"
+	sqldriver "database/sql/driver"
+	"errors"
+	"io"
+	"math/rand"
+	"net"
+	"net/http"
+	"syscall"
+	"time"
+
+	"github.com/lib/pq"
+	providerlib "github.com/pokt-foundation/pocket-go/provider"
+	indexerlib "github.com/pokt-foundation/pocket-indexer-lib"
+)
+
+const (
+	errorClassPermanent = "permanent"
+	errorClassTransient = "transient"
+	errorClassUnknown   = "unknown"
+
+	retryMultiplier = 2
+	// retryJitter is the fraction of the interval randomly added or removed from each wait
+	retryJitter = 0.5
+)
+
+// Postgres error classes that will fail again no matter how many times they are retried
+// https://www.postgresql.org/docs/current/errcodes-appendix.html
+var permanentPostgresErrorClasses = map[pq.ErrorClass]bool{
+	"22": true, // data exception
+	"23": true, // integrity constraint violation
+	"42": true, // syntax error or access rule violation
+}
+
+// RPC error codes of requests that will fail again no matter how many times they are retried
+var permanentRPCErrorCodes = map[int]bool{
+	http.StatusNotFound:         true,
+	http.StatusMethodNotAllowed: true,
+}
+
+// retryPolicy retries operations with exponential backoff and jitter until they succeed,
+// fail with a permanent error or run out of attempts or elapsed time
+type retryPolicy struct {
+	maxAttempts     int64
+	initialInterval time.Duration
+	maxInterval     time.Duration
+	maxElapsedTime  time.Duration
+}
+
+func (p *retryPolicy) do(ctx context.Context, stage string, operation func() error) error {
+	start := time.Now()
+	interval := p.initialInterval
+
+	for attempt := int64(1); ; attempt++ {
+		err := operation()
+		if err == nil || isPermanentError(err) || attempt >= p.maxAttempts {
+			return err
+		}
+
+		wait := addJitter(interval)
+		if time.Since(start)+wait > p.maxElapsedTime {
+			return err
+		}
+
+		retriesCounter.WithLabelValues(stage).Inc()
+
+		select {
+		case <-ctx.Done():
+			return err
+		case <-time.After(wait):
+		}
+
+		interval = p.nextInterval(interval)
+	}
+}
+
+func (p *retryPolicy) nextInterval(interval time.Duration) time.Duration {
+	interval *= retryMultiplier
+	if interval > p.maxInterval {
+		return p.maxInterval
+	}
+
+	return interval
+}
+
+func addJitter(interval time.Duration) time.Duration {
+	delta := retryJitter * float64(interval)
+
+	return time.Duration(float64(interval) - delta + rand.Float64()*2*delta)
+}
+
+// isPermanentError reports whether retrying the error is pointless: the height has nothing to index,
+// the node rejected the request or the database rejected the data
+func isPermanentError(err error) bool {
+	if isNothingToIndex(err) || errors.Is(err, indexerlib.ErrBlockHasNoHash) {
+		return true
+	}
+
+	if isPermanentProviderError(err) {
+		return true
+	}
+
+	return isPermanentDatabaseError(err)
+}
+
+// isPermanentProviderError reports RPC errors of requests the node will never serve, like unknown routes
+// The RPC errors of heights the node does not have yet and the other 4xx responses, 429 among them, are retried
+func isPermanentProviderError(err error) bool {
+	var rpcErr *providerlib.RPCError
+
+	return errors.As(err, &rpcErr) && permanentRPCErrorCodes[rpcErr.Code]
+}
+
+func isPermanentDatabaseError(err error) bool {
+	var pqErr *pq.Error
+
+	return errors.As(err, &pqErr) && permanentPostgresErrorClasses[pqErr.Code.Class()]
+}
+
+// isTransientError reports errors known to be fixed by waiting: the overload errors of a node
+// and the RPC errors not permanent, which near the tip are mostly heights the node does not have yet
+func isTransientError(err error) bool {
+	var rpcErr *providerlib.RPCError
+
+	return isOverloadError(err) || (errors.As(err, &rpcErr) && !permanentRPCErrorCodes[rpcErr.Code])
+}
+
+// isConnectionError reports timeouts, dropped connections and 5xx responses
+func isConnectionError(err error) bool {
+	var netErr net.Error
+
+	if errors.As(err, &netErr) && netErr.Timeout() {
+		return true
+	}
+
+	return errors.Is(err, providerlib.Err5xxOnConnection) ||
+		errors.Is(err, syscall.ECONNRESET) ||
+		errors.Is(err, syscall.ECONNREFUSED) ||
+		errors.Is(err, io.ErrUnexpectedEOF) ||
+		errors.Is(err, sqldriver.ErrBadConn) ||
+		errors.Is(err, context.DeadlineExceeded)
+}
+
+// classifyError returns whether the error is permanent, transient or unknown, unknown errors are retried
+func classifyError(err error) string {
+	if isPermanentError(err) {
+		return errorClassPermanent
+	}
+
+	if isTransientError(err) {
+		return errorClassTransient
+	}
+
+	return errorClassUnknown
+}
+
+// canFallback reports whether the fallback node could succeed where the main node failed,
+// which is not the case when there is nothing to index or the database rejected the data
+func canFallback(err error) bool {
+	return !isNothingToIndex(err) && !isPermanentDatabaseError(err)
+}
@@ -0,0 +1,141 @@
+package main
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"syscall"
+	"testing"
+	"time"
+
+	"github.com/lib/pq"
+	providerlib "github.com/pokt-foundation/pocket-go/provider"
+	indexerlib "github.com/pokt-foundation/pocket-indexer-lib"
+	"github.com/stretchr/testify/require"
+)
+
+func TestIsPermanentError(t *testing.T) {
+	tests := []struct {
+		name      string
+		err       error
+		permanent bool
+		class     string
+	}{
+		{name: "nothing to index", err: indexerlib.ErrNoTransactionsToIndex, permanent: true, class: errorClassPermanent},
+		{name: "block without hash", err: indexerlib.ErrBlockHasNoHash, permanent: true, class: errorClassPermanent},
+		{name: "4xx response, 429 included", err: providerlib.Err4xxOnConnection, class: errorClassTransient},
+		{name: "5xx response", err: providerlib.Err5xxOnConnection, class: errorClassTransient},
+		{name: "height not available yet", err: &providerlib.RPCError{Code: http.StatusBadRequest, Message: "height not available"}, class: errorClassTransient},
+		{name: "unknown route", err: &providerlib.RPCError{Code: http.StatusNotFound}, permanent: true, class: errorClassPermanent},
+		{name: "wrapped rpc error of a node", err: &nodeError{node: "node", err: &providerlib.RPCError{Code: http.StatusMethodNotAllowed}}, permanent: true, class: errorClassPermanent},
+		{name: "integrity constraint violation", err: &pq.Error{Code: "23505"}, permanent: true, class: errorClassPermanent},
+		{name: "serialization failure", err: &pq.Error{Code: "40001"}, class: errorClassUnknown},
+		{name: "connection reset", err: fmt.Errorf("read: %w", syscall.ECONNRESET), class: errorClassTransient},
+		{name: "deadline exceeded", err: context.DeadlineExceeded, class: errorClassTransient},
+		{name: "unknown error", err: errors.New("dummy error"), class: errorClassUnknown},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			c := require.New(t)
+
+			c.Equal(tt.permanent, isPermanentError(tt.err))
+			c.Equal(tt.class, classifyError(tt.err))
+		})
+	}
+}
+
+func TestIsOverloadError(t *testing.T) {
+	tests := []struct {
+		name     string
+		err      error
+		overload bool
+	}{
+		{name: "no error"},
+		{name: "4xx response, 429 included", err: providerlib.Err4xxOnConnection, overload: true},
+		{name: "5xx response", err: providerlib.Err5xxOnConnection, overload: true},
+		{name: "connection refused", err: syscall.ECONNREFUSED, overload: true},
+		{name: "height not available yet", err: &providerlib.RPCError{Code: http.StatusBadRequest}},
+		{name: "nothing to index", err: indexerlib.ErrNoNodesToIndex},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			require.Equal(t, tt.overload, isOverloadError(tt.err))
+		})
+	}
+}
+
+func TestCanFallback(t *testing.T) {
+	c := require.New(t)
+
+	c.True(canFallback(providerlib.Err4xxOnConnection))
+	c.True(canFallback(&providerlib.RPCError{Code: http.StatusNotFound}))
+	c.False(canFallback(indexerlib.ErrNoAppsToIndex))
+	c.False(canFallback(&pq.Error{Code: "42P01"}))
+}
+
+func TestRetryPolicy_Do(t *testing.T) {
+	policy := &retryPolicy{
+		maxAttempts:     3,
+		initialInterval: time.Millisecond,
+		maxInterval:     2 * time.Millisecond,
+		maxElapsedTime:  time.Second,
+	}
+
+	tests := []struct {
+		name     string
+		errs     []error
+		attempts int
+		err      error
+	}{
+		{name: "success", errs: []error{nil}, attempts: 1},
+		{name: "transient then success", errs: []error{providerlib.Err5xxOnConnection, nil}, attempts: 2},
+		{name: "permanent", errs: []error{indexerlib.ErrBlockHasNoHash}, attempts: 1, err: indexerlib.ErrBlockHasNoHash},
+		{
+			name:     "out of attempts",
+			errs:     []error{providerlib.Err4xxOnConnection, providerlib.Err4xxOnConnection, providerlib.Err4xxOnConnection},
+			attempts: 3,
+			err:      providerlib.Err4xxOnConnection,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			c := require.New(t)
+
+			attempts := 0
+
+			err := policy.do(context.Background(), "block", func() error {
+				err := tt.errs[attempts]
+				attempts++
+
+				return err
+			})
+
+			c.Equal(tt.err, err)
+			c.Equal(tt.attempts, attempts)
+		})
+	}
+}
+
+func TestRetryPolicy_NextInterval(t *testing.T) {
+	c := require.New(t)
+
+	policy := &retryPolicy{maxInterval: 3 * time.Second}
+
+	c.Equal(2*time.Second, policy.nextInterval(time.Second))
+	c.Equal(3*time.Second, policy.nextInterval(2*time.Second))
+}
+
+func TestAddJitter(t *testing.T) {
+	c := require.New(t)
+
+	for i := 0; i < 100; i++ {
+		wait := addJitter(time.Second)
+
+		c.GreaterOrEqual(wait, 500*time.Millisecond)
+		c.LessOrEqual(wait, 1500*time.Millisecond)
+	}
+}
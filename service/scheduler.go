@@ -0,0 +1,493 @@
+package main
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	postgresdriver "github.com/pokt-foundation/pocket-indexer-lib/postgres-driver"
+	"github.com/pokt-foundation/pocket-indexer-services/storage"
+)
+
+// heightTask is a height queued for indexing with the stages to launch for it,
+// a task without stages only marks the height as complete
+type heightTask struct {
+	height int
+	stages []string
+	// ctx cancels the stages of the height before the run does, like a lease lost while they are in flight,
+	// it is derived from the run context and nil when only the run cancels them
+	ctx context.Context
+}
+
+// taskQueues are the queues the heights are consumed from, tip heights are always taken first
+// so the chain tip keeps being followed while a long backfill is running
+type taskQueues struct {
+	tip      <-chan *heightTask
+	backfill <-chan *heightTask
+}
+
+func (q *taskQueues) open() bool {
+	return q.tip != nil || q.backfill != nil
+}
+
+// next returns the next task, nil when a queue was closed or ctx was cancelled
+func (q *taskQueues) next(ctx context.Context) *heightTask {
+	select {
+	case task, ok := <-q.tip:
+		return received(task, ok, &q.tip)
+	default:
+	}
+
+	select {
+	case task, ok := <-q.tip:
+		return received(task, ok, &q.tip)
+	case task, ok := <-q.backfill:
+		return received(task, ok, &q.backfill)
+	case <-ctx.Done():
+		return nil
+	}
+}
+
+// received stops receiving from a closed queue, a nil queue is never selected again
+func received(task *heightTask, ok bool, queue *<-chan *heightTask) *heightTask {
+	if !ok {
+		*queue = nil
+		return nil
+	}
+
+	return task
+}
+
+// schedule streams the heights to index from the producers of the running mode into bounded queues
+// and indexes them as the semaphore allows, a producer failing stops the others
+func (s *service) schedule(ctx context.Context) error {
+	produceCtx, cancelProduce := context.WithCancel(ctx)
+	defer cancelProduce()
+
+	tipTasks := make(chan *heightTask, s.queueSize)
+	backfillTasks := make(chan *heightTask, s.queueSize)
+	produceErrs := make(chan error, 2)
+
+	switch s.mode {
+	case modeBackfillGaps:
+		close(tipTasks)
+		go runProducer(cancelProduce, backfillTasks, produceErrs, func() error {
+			return s.produceMissingHeights(produceCtx, backfillTasks)
+		})
+	case modeRange:
+		close(tipTasks)
+		go runProducer(cancelProduce, backfillTasks, produceErrs, func() error {
+			return s.produceHeightRange(produceCtx, backfillTasks)
+		})
+	case modeSnapshot:
+		close(tipTasks)
+		go runProducer(cancelProduce, backfillTasks, produceErrs, func() error {
+			return s.produceSnapshot(produceCtx)
+		})
+	case modeReindex:
+		close(tipTasks)
+		go runProducer(cancelProduce, backfillTasks, produceErrs, func() error {
+			return s.produceReindex(produceCtx, backfillTasks)
+		})
+	default:
+		err := s.startFollowing(produceCtx, cancelProduce, tipTasks, backfillTasks, produceErrs)
+		if err != nil {
+			return err
+		}
+	}
+
+	err := s.consumeTasks(ctx, tipTasks, backfillTasks)
+
+	return getProduceError(produceErrs, err)
+}
+
+func runProducer(cancelProduce context.CancelFunc, tasks chan<- *heightTask, produceErrs chan<- error, produce func() error) {
+	defer close(tasks)
+
+	err := produce()
+	if err != nil {
+		cancelProduce()
+	}
+
+	produceErrs <- err
+}
+
+// getProduceError returns the first error of the finished producers that was not caused by a cancellation
+func getProduceError(produceErrs chan error, consumeErr error) error {
+	close(produceErrs)
+
+	for err := range produceErrs {
+		if err != nil && !errors.Is(err, context.Canceled) {
+			return err
+		}
+	}
+
+	return consumeErr
+}
+
+// resumePoint is where following resumes from, the committed height of each stage
+// and the heights saved and reported by the chain when it started
+type resumePoint struct {
+	committedHeights map[string]int
+	maxSavedHeight   int
+	chainHeight      int
+}
+
+// startFollowing resumes each stage from its committed height, backfilling up to the current chain height
+// while the heights above it are followed, the replicas leasing heights only index the ranges leased to them
+func (s *service) startFollowing(ctx context.Context, cancelProduce context.CancelFunc, tipTasks, backfillTasks chan *heightTask, produceErrs chan error) error {
+	if s.leasing != nil {
+		close(backfillTasks)
+		go runProducer(cancelProduce, tipTasks, produceErrs, func() error {
+			return s.produceLeasedHeights(ctx, tipTasks)
+		})
+
+		return nil
+	}
+
+	resume, err := s.getResumePoint()
+	if err != nil {
+		return err
+	}
+
+	for stage, committedHeight := range resume.committedHeights {
+		s.heightsInFlight.setCommitted(stage, committedHeight)
+	}
+
+	err = s.loadFailedHeights(getMinCommitted(resume.committedHeights) + 1)
+	if err != nil {
+		return err
+	}
+
+	log.WithField("committed_heights", resume.committedHeights).
+		Info(fmt.Sprintf("Resuming from committed heights, backfilling up to height %d", resume.chainHeight))
+
+	go runProducer(cancelProduce, tipTasks, produceErrs, func() error {
+		return s.followTip(ctx, tipTasks, resume.chainHeight+1)
+	})
+	go runProducer(cancelProduce, backfillTasks, produceErrs, func() error {
+		return s.produceBackfill(ctx, backfillTasks, resume)
+	})
+
+	return nil
+}
+
+// getResumePoint reads the committed height of each stage,
+// databases indexed before a stage saved its committed height are trusted up to their max saved height
+func (s *service) getResumePoint() (*resumePoint, error) {
+	maxSavedHeight, err := s.driver.GetMaxHeightInBlocks()
+	if err != nil && !errors.Is(err, postgresdriver.ErrNoPreviousHeight) {
+		return nil, err
+	}
+
+	committedHeights := make(map[string]int, len(s.trackedStages))
+
+	for _, stage := range s.trackedStages {
+		committedHeight, err := s.driver.ReadProgress(stage)
+		if errors.Is(err, storage.ErrNoProgress) {
+			committedHeight, err = int(maxSavedHeight), nil
+		}
+
+		if err != nil {
+			return nil, err
+		}
+
+		committedHeights[stage] = committedHeight
+	}
+
+	chainHeight, err := s.getChainHeight(int(maxSavedHeight))
+	if err != nil {
+		return nil, err
+	}
+
+	return &resumePoint{
+		committedHeights: committedHeights,
+		maxSavedHeight:   int(maxSavedHeight),
+		chainHeight:      chainHeight,
+	}, nil
+}
+
+// loadFailedHeights keeps the stages of the failed heights from completing until they are re-driven,
+// the poisoned ones are dead letters left to the replay command so they do not hold the committed heights
+func (s *service) loadFailedHeights(fromHeight int) error {
+	failedHeights, err := s.driver.ReadFailedHeightsFromHeight(fromHeight)
+	if err != nil {
+		return err
+	}
+
+	for _, failedHeight := range failedHeights {
+		if failedHeight.Poisoned {
+			continue
+		}
+
+		s.heightsInFlight.fail(failedHeight.Height, failedHeight.Stage, failedHeight.Address)
+	}
+
+	return nil
+}
+
+// getStagesToQueue returns the tracked stages behind the height that are missing there,
+// accounts are only queued on their own when no stage fanning them out is
+func (r *resumePoint) getStagesToQueue(height int, missingStages []string) []string {
+	// Nothing above the max saved height is trusted as saved
+	if height > r.maxSavedHeight {
+		missingStages = storage.Stages
+	}
+
+	var stages []string
+	fansOutAccounts := false
+
+	for _, stage := range storage.Stages {
+		if stage != storage.StageAccounts && r.isMissing(stage, height, missingStages) {
+			stages = append(stages, stage)
+			fansOutAccounts = fansOutAccounts || fanOutStages[stage]
+		}
+	}
+
+	if !fansOutAccounts && r.isMissing(storage.StageAccounts, height, missingStages) {
+		stages = append(stages, storage.StageAccounts)
+	}
+
+	return stages
+}
+
+// isMissing reports whether the stage is tracked, behind the height and missing there
+func (r *resumePoint) isMissing(stage string, height int, missingStages []string) bool {
+	committedHeight, ok := r.committedHeights[stage]
+
+	return ok && committedHeight < height && containsStage(missingStages, stage)
+}
+
+func containsStage(stages []string, stage string) bool {
+	for _, s := range stages {
+		if s == stage {
+			return true
+		}
+	}
+
+	return false
+}
+
+func (s *service) getChainHeight(maxSavedHeight int) (int, error) {
+	currentHeight, err := s.provider.GetBlockHeight()
+	if err != nil {
+		currentHeight, err = s.fallbackProvider.GetBlockHeight()
+		if err != nil {
+			return 0, err
+		}
+	}
+
+	setHeightsMetrics(maxSavedHeight, currentHeight)
+
+	return currentHeight, nil
+}
+
+// readChainHeight returns the chain height, reading the max saved height for the heights metrics
+func (s *service) readChainHeight() (int, error) {
+	maxSavedHeight, err := s.driver.GetMaxHeightInBlocks()
+	if err != nil && !errors.Is(err, postgresdriver.ErrNoPreviousHeight) {
+		return 0, err
+	}
+
+	return s.getChainHeight(int(maxSavedHeight))
+}
+
+// sendTask queues the height until it is launched, false means ctx was cancelled before it could be queued
+func (s *service) sendTask(ctx context.Context, tasks chan<- *heightTask, height int, stages []string) bool {
+	return s.queueTask(ctx, tasks, &heightTask{height: height, stages: stages})
+}
+
+func (s *service) queueTask(ctx context.Context, tasks chan<- *heightTask, task *heightTask) bool {
+	s.heightsInFlight.queue(task.height)
+
+	select {
+	case tasks <- task:
+		return true
+	case <-ctx.Done():
+		s.heightsInFlight.cancel(task.height)
+		return false
+	}
+}
+
+// produceBackfill queues the heights after the lowest committed height up to the chain height,
+// with the stages behind each height that are missing there
+func (s *service) produceBackfill(ctx context.Context, tasks chan<- *heightTask, resume *resumePoint) error {
+	fromHeight := getMinCommitted(resume.committedHeights) + 1
+	stagesByHeight := make(map[int][]string)
+
+	if fromHeight <= resume.maxSavedHeight {
+		missingHeights, err := s.driver.ReadMissingHeights(fromHeight, resume.maxSavedHeight)
+		if err != nil {
+			return err
+		}
+
+		_, stagesByHeight = groupMissingHeights(missingHeights, s.trackedStages)
+	}
+
+	for height := fromHeight; height <= resume.chainHeight; height++ {
+		if !s.sendTask(ctx, tasks, height, resume.getStagesToQueue(height, stagesByHeight[height])) {
+			return ctx.Err()
+		}
+	}
+
+	log.Info(fmt.Sprintf("Backfill queued through height %d", resume.chainHeight))
+
+	return s.sweepGaps(ctx, tasks)
+}
+
+// followTip queues the heights added to the chain every request interval,
+// checking the hashes of the recent ones after each round
+func (s *service) followTip(ctx context.Context, tasks chan<- *heightTask, nextHeight int) error {
+	for {
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-time.After(s.getRequestInterval()):
+		}
+
+		heartbeat()
+
+		var err error
+
+		nextHeight, err = s.queueNewHeights(ctx, tasks, nextHeight)
+		if err != nil {
+			return err
+		}
+
+		s.checkRecentBlockHashes(ctx, tasks, nextHeight-1)
+	}
+}
+
+// queueNewHeights queues the heights from given one up to the chain height and returns the next one to queue
+func (s *service) queueNewHeights(ctx context.Context, tasks chan<- *heightTask, nextHeight int) (int, error) {
+	chainHeight, err := s.readChainHeight()
+	if err != nil {
+		return nextHeight, err
+	}
+
+	for ; nextHeight <= chainHeight; nextHeight++ {
+		if !s.sendTask(ctx, tasks, nextHeight, s.stages) {
+			return nextHeight, ctx.Err()
+		}
+	}
+
+	return nextHeight, nil
+}
+
+// produceHeightRange queues every height between the from and to heights
+func (s *service) produceHeightRange(ctx context.Context, tasks chan<- *heightTask) error {
+	err := s.checkHeightRangeInChain()
+	if err != nil {
+		return err
+	}
+
+	for _, stage := range s.trackedStages {
+		s.heightsInFlight.setCommitted(stage, s.fromHeight-1)
+	}
+
+	for height := s.fromHeight; height <= s.toHeight; height++ {
+		if !s.sendTask(ctx, tasks, height, s.stages) {
+			return ctx.Err()
+		}
+	}
+
+	return nil
+}
+
+// produceReindex deletes everything saved between the from and to heights and queues them again
+func (s *service) produceReindex(ctx context.Context, tasks chan<- *heightTask) error {
+	err := s.checkHeightRangeInChain()
+	if err != nil {
+		return err
+	}
+
+	for height := s.fromHeight; height <= s.toHeight; height++ {
+		err = s.repairHeight(ctx, tasks, height)
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// produceSnapshot queues the accounts of the snapshot addresses every snapshot step heights from the from height
+// up to the to height, they go straight to the account queue since no stage is indexed
+func (s *service) produceSnapshot(ctx context.Context) error {
+	err := s.checkHeightRangeInChain()
+	if err != nil {
+		return err
+	}
+
+	for height := s.fromHeight; height <= s.toHeight; height += s.snapshotStep {
+		for _, address := range s.snapshotAddresses {
+			task := &accountTask{height: height, address: address, accountType: storage.AccountTypeWallet}
+
+			if !s.queueAccount(ctx, task) {
+				return ctx.Err()
+			}
+		}
+	}
+
+	log.Info(fmt.Sprintf("Snapshot of %d addresses queued through height %d", len(s.snapshotAddresses), s.toHeight))
+
+	return nil
+}
+
+func (s *service) checkHeightRangeInChain() error {
+	chainHeight, err := s.readChainHeight()
+	if err != nil {
+		return err
+	}
+
+	if s.toHeight > chainHeight {
+		return errInputHeightIsHigherThanCurrentHeight
+	}
+
+	return nil
+}
+
+// consumeTasks launches the stages of the queued heights until every queue is closed,
+// once ctx is cancelled no more heights are launched and the ones in flight are drained
+func (s *service) consumeTasks(ctx context.Context, tipTasks, backfillTasks <-chan *heightTask) error {
+	queues := &taskQueues{tip: tipTasks, backfill: backfillTasks}
+
+	for queues.open() && ctx.Err() == nil {
+		task := queues.next(ctx)
+		if task == nil {
+			continue
+		}
+
+		if !s.launchTask(ctx, task) {
+			break
+		}
+	}
+
+	return s.waitIndexingProcesses(ctx)
+}
+
+func (s *service) launchTask(ctx context.Context, task *heightTask) bool {
+	if len(task.stages) > 0 {
+		err := s.semaphore.Acquire(ctx, int64(len(task.stages)))
+		if err != nil {
+			s.logInfoWithFields("Shutdown stopped launching heights", "", task.height)
+			return false
+		}
+	}
+
+	semaphoreInUseGauge.Add(float64(len(task.stages)))
+	s.indexingProcesses.Add(len(task.stages))
+	s.heightsInFlight.launch(task.height, task.stages)
+
+	stageCtx := ctx
+	if task.ctx != nil {
+		stageCtx = task.ctx
+	}
+
+	for _, stage := range task.stages {
+		go s.indexStage(stageCtx, stage, task.height)
+	}
+
+	return true
+}
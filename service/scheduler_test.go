@@ -0,0 +1,211 @@
+package main
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/pokt-foundation/pocket-indexer-services/storage"
+	"github.com/stretchr/testify/require"
+)
+
+func TestTaskQueues_Next(t *testing.T) {
+	c := require.New(t)
+
+	tip := make(chan *heightTask, 2)
+	backfill := make(chan *heightTask, 2)
+
+	backfill <- &heightTask{height: 1}
+	backfill <- &heightTask{height: 2}
+	tip <- &heightTask{height: 100}
+
+	queues := &taskQueues{tip: tip, backfill: backfill}
+
+	// The tip is taken first even with backfill heights waiting
+	c.Equal(100, queues.next(context.Background()).height)
+	c.Equal(1, queues.next(context.Background()).height)
+
+	close(tip)
+
+	// A closed queue is no longer received from
+	c.Nil(queues.next(context.Background()))
+	c.Nil(queues.tip)
+	c.True(queues.open())
+
+	c.Equal(2, queues.next(context.Background()).height)
+
+	close(backfill)
+
+	c.Nil(queues.next(context.Background()))
+	c.False(queues.open())
+}
+
+func TestTaskQueues_NextCancelled(t *testing.T) {
+	c := require.New(t)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	queues := &taskQueues{tip: make(chan *heightTask), backfill: make(chan *heightTask)}
+
+	c.Nil(queues.next(ctx))
+	c.True(queues.open())
+}
+
+func TestQueueTask_Backpressure(t *testing.T) {
+	c := require.New(t)
+
+	s := newTestService(&fakeIndexer{}, &fakeDriver{})
+
+	tasks := make(chan *heightTask, 1)
+
+	c.True(s.sendTask(context.Background(), tasks, 1, nil))
+	c.True(s.heightsInFlight.isScheduled(1))
+
+	// A full queue holds the producer until a height is consumed
+	queued := make(chan bool)
+
+	go func() {
+		queued <- s.sendTask(context.Background(), tasks, 2, nil)
+	}()
+
+	select {
+	case <-queued:
+		c.Fail("height queued over the queue size")
+	case <-time.After(20 * time.Millisecond):
+	}
+
+	c.Equal(1, (<-tasks).height)
+	c.True(<-queued)
+
+	// A shutdown stops the producer held by a full queue, cancelling the height it was queueing
+	ctx, cancel := context.WithCancel(context.Background())
+
+	go func() {
+		queued <- s.sendTask(ctx, tasks, 3, nil)
+	}()
+
+	cancel()
+
+	c.False(<-queued)
+	c.True(s.heightsInFlight.isRangeAborted(3, 3))
+	c.False(s.heightsInFlight.isScheduled(3))
+}
+
+func TestGetProduceError(t *testing.T) {
+	tests := []struct {
+		name       string
+		produced   []error
+		consumeErr error
+		err        error
+	}{
+		{name: "producers finished", produced: []error{nil, nil}},
+		{name: "producer failed", produced: []error{context.Canceled, errDummy}, err: errDummy},
+		{name: "cancelled producers", produced: []error{context.Canceled}, consumeErr: context.Canceled, err: context.Canceled},
+		{name: "consume error", produced: []error{nil}, consumeErr: errDummy, err: errDummy},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			c := require.New(t)
+
+			produceErrs := make(chan error, len(tt.produced))
+			for _, err := range tt.produced {
+				produceErrs <- err
+			}
+
+			c.Equal(tt.err, getProduceError(produceErrs, tt.consumeErr))
+		})
+	}
+}
+
+func TestRunProducer(t *testing.T) {
+	c := require.New(t)
+
+	ctx, cancelProduce := context.WithCancel(context.Background())
+	defer cancelProduce()
+
+	tasks := make(chan *heightTask)
+	produceErrs := make(chan error, 1)
+
+	// A producer failing closes its queue and cancels the others
+	runProducer(cancelProduce, tasks, produceErrs, func() error {
+		return errDummy
+	})
+
+	_, ok := <-tasks
+	c.False(ok)
+	c.Equal(errDummy, <-produceErrs)
+	c.Error(ctx.Err())
+}
+
+// newSnapshotService returns a service taking a snapshot of two addresses every 10 heights from 10 to 35
+func newSnapshotService(indexer *fakeIndexer) *service {
+	s := newTestService(indexer, &fakeDriver{maxHeight: 40})
+	provider := &fakeProvider{chainHeight: 50}
+	s.provider, s.fallbackProvider = provider, provider
+	s.mode = modeSnapshot
+	s.fromHeight, s.toHeight = 10, 35
+	s.snapshotStep = 10
+	s.snapshotAddresses = []string{"a1", "b2"}
+	s.accountConcurrency = 2
+	s.drain = newDrain()
+
+	return s
+}
+
+func TestService_ScheduleSnapshot(t *testing.T) {
+	c := require.New(t)
+
+	indexer := &fakeIndexer{}
+	s := newSnapshotService(indexer)
+
+	stop := make(chan struct{})
+	s.runAccountWorkers(context.Background(), stop)
+
+	c.NoError(s.schedule(context.Background()))
+
+	close(stop)
+	<-waitGroupDone(s.backgroundProcesses)
+
+	// Only the wallets of the addresses are indexed at each step, no stage is
+	accounts := indexer.getAccounts()
+	c.ElementsMatch([]accountTask{
+		{height: 10, address: "a1", accountType: storage.AccountTypeWallet},
+		{height: 10, address: "b2", accountType: storage.AccountTypeWallet},
+		{height: 20, address: "a1", accountType: storage.AccountTypeWallet},
+		{height: 20, address: "b2", accountType: storage.AccountTypeWallet},
+		{height: 30, address: "a1", accountType: storage.AccountTypeWallet},
+		{height: 30, address: "b2", accountType: storage.AccountTypeWallet},
+	}, accounts)
+}
+
+func TestService_ProduceSnapshot(t *testing.T) {
+	c := require.New(t)
+
+	s := newSnapshotService(&fakeIndexer{})
+
+	// A range over the chain height is not queued
+	s.toHeight = 60
+
+	c.Equal(errInputHeightIsHigherThanCurrentHeight, s.produceSnapshot(context.Background()))
+	c.Empty(s.accountQueue.tasks)
+
+	// A shutdown stops the snapshot held by a full account queue
+	s.toHeight = 35
+	s.accountQueue = newAccountQueue(1)
+
+	ctx, cancel := context.WithCancel(context.Background())
+
+	go func() {
+		time.Sleep(10 * time.Millisecond)
+		cancel()
+	}()
+
+	c.ErrorIs(s.produceSnapshot(ctx), context.Canceled)
+	c.Len(s.accountQueue.tasks, 1)
+
+	task := <-s.accountQueue.tasks
+	s.accountQueue.unmark(task)
+	s.releaseAccount(task.height)
+}
@@ -0,0 +1,91 @@
+package main
+
+import (
+	"context"
+	"sync"
+	"time"
+)
+
+const (
+	exitCodeSuccess = iota
+	exitCodeFailure
+	exitCodeIncompleteHeights
+)
+
+// drain is the deadline of a run to wait for its heights in flight and background processes once it is cancelled,
+// expired is closed when they are no longer waited for
+type drain struct {
+	expired chan struct{}
+	once    sync.Once
+}
+
+func newDrain() *drain {
+	return &drain{expired: make(chan struct{})}
+}
+
+// expire stops waiting right away
+func (d *drain) expire() {
+	d.once.Do(func() {
+		close(d.expired)
+	})
+}
+
+// expireAfter expires the drain once the timeout has passed since ctx was cancelled,
+// it returns right away when the drain expires before
+func (d *drain) expireAfter(ctx context.Context, timeout time.Duration) {
+	select {
+	case <-ctx.Done():
+	case <-d.expired:
+		return
+	}
+
+	timer := time.NewTimer(timeout)
+	defer timer.Stop()
+
+	select {
+	case <-timer.C:
+		d.expire()
+	case <-d.expired:
+	}
+}
+
+// waitIndexingProcesses waits for the indexing processes, when ctx is cancelled
+// it only waits until the shutdown timeout expires
+func (s *service) waitIndexingProcesses(ctx context.Context) error {
+	done := waitGroupDone(s.indexingProcesses)
+
+	select {
+	case <-done:
+		return ctx.Err()
+	case <-ctx.Done():
+	}
+
+	log.Info("Shutting down, draining heights in flight")
+
+	select {
+	case <-done:
+	case <-s.drain.expired:
+		log.Error("Shutdown timeout expired before heights in flight were drained")
+	}
+
+	return ctx.Err()
+}
+
+func (s *service) waitBackgroundProcesses() {
+	select {
+	case <-waitGroupDone(s.backgroundProcesses):
+	case <-s.drain.expired:
+		log.Error("Shutdown timeout expired before background processes finished")
+	}
+}
+
+func waitGroupDone(waitGroup *sync.WaitGroup) <-chan struct{} {
+	done := make(chan struct{})
+
+	go func() {
+		waitGroup.Wait()
+		close(done)
+	}()
+
+	return done
+}
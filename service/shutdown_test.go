@@ -0,0 +1,45 @@
+package main
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestDrain_ExpireAfter(t *testing.T) {
+	c := require.New(t)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	// A run finished without a cancellation expires its drain, which stops waiting for ctx
+	d := newDrain()
+	returned := make(chan struct{})
+
+	go func() {
+		defer close(returned)
+		d.expireAfter(ctx, time.Hour)
+	}()
+
+	d.expire()
+
+	select {
+	case <-returned:
+	case <-time.After(time.Second):
+		c.Fail("drain still waiting for the cancellation")
+	}
+
+	// A cancelled run expires its drain once the timeout has passed
+	d = newDrain()
+
+	cancel()
+	go d.expireAfter(ctx, 10*time.Millisecond)
+
+	select {
+	case <-d.expired:
+	case <-time.After(time.Second):
+		c.Fail("drain not expired after the timeout")
+	}
+}
@@ -0,0 +1,101 @@
+package storage
+
+import (
+	"math/big"
+
+	"github.com/lib/pq"
+	indexerlib "github.com/pokt-foundation/pocket-indexer-lib"
+)
+
+const selectAccountsAtHeightsScript = `
+	SELECT k.key_height, a.* FROM unnest($1::text[], $2::bigint[]) AS k(key_address, key_height)
+	CROSS JOIN LATERAL (
+		SELECT * FROM accounts WHERE address = k.key_address AND height <= k.key_height ORDER BY height DESC LIMIT 1
+	) a`
+
+// dbAccount struct handler for a row of the accounts table saved by the indexer
+type dbAccount struct {
+	ID                  int    `db:"id"`
+	Address             string `db:"address"`
+	Height              int    `db:"height"`
+	AccountType         string `db:"account_type"`
+	Balance             string `db:"balance"`
+	BalanceDenomination string `db:"balance_denomination"`
+}
+
+func (a *dbAccount) toIndexerAccount() *indexerlib.Account {
+	balance, _ := new(big.Int).SetString(a.Balance, 10)
+
+	return &indexerlib.Account{
+		Address:             a.Address,
+		Height:              a.Height,
+		AccountType:         indexerlib.AccountType(a.AccountType),
+		Balance:             balance,
+		BalanceDenomination: a.BalanceDenomination,
+	}
+}
+
+// ReadAccountsPage returns a keyset page of the accounts at the height by address and type and whether there are more past it,
+// the accounts of the max height saved when height is 0
+// An address can have an account of each type at a height so the type is part of the key
+func (d *PostgresDriver) ReadAccountsPage(height int, options *PageOptions) ([]*indexerlib.Account, bool, error) {
+	query := &pageQuery{table: "accounts", key: []string{"address", "account_type"}, cursorKey: addressTypeCursorKey}
+	query.whereListHeight(height)
+
+	var dbAccounts []*dbAccount
+
+	script, args := query.build(options)
+
+	err := d.Select(&dbAccounts, d.Rebind(script), args...)
+	if err != nil {
+		return nil, false, err
+	}
+
+	indexes, hasMore := getPageIndexes(len(dbAccounts), options)
+	accounts := make([]*indexerlib.Account, 0, len(indexes))
+
+	for _, i := range indexes {
+		accounts = append(accounts, dbAccounts[i].toIndexerAccount())
+	}
+
+	return accounts, hasMore, nil
+}
+
+// AccountKey struct handler for the account of an address as of a height
+type AccountKey struct {
+	Address string
+	Height  int
+}
+
+// dbAccountAtHeight struct handler for an account read as of the height of its key
+type dbAccountAtHeight struct {
+	KeyHeight int `db:"key_height"`
+	dbAccount
+}
+
+// ReadAccountsAtHeights returns the accounts of the keys as of their heights, the last ones saved at or before them,
+// the keys without an account saved by then are left out
+func (d *PostgresDriver) ReadAccountsAtHeights(keys []AccountKey) (map[AccountKey]*indexerlib.Account, error) {
+	addresses := make([]string, 0, len(keys))
+	heights := make([]int64, 0, len(keys))
+
+	for _, key := range keys {
+		addresses = append(addresses, key.Address)
+		heights = append(heights, int64(key.Height))
+	}
+
+	var dbAccounts []*dbAccountAtHeight
+
+	err := d.Select(&dbAccounts, selectAccountsAtHeightsScript, pq.Array(addresses), pq.Array(heights))
+	if err != nil {
+		return nil, err
+	}
+
+	accounts := make(map[AccountKey]*indexerlib.Account, len(dbAccounts))
+
+	for _, dbAccount := range dbAccounts {
+		accounts[AccountKey{Address: dbAccount.Address, Height: dbAccount.KeyHeight}] = dbAccount.toIndexerAccount()
+	}
+
+	return accounts, nil
+}
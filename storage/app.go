@@ -0,0 +1,54 @@
+package storage
+
+import (
+	"math/big"
+
+	indexerlib "github.com/pokt-foundation/pocket-indexer-lib"
+)
+
+// dbApp struct handler for a row of the apps table saved by the indexer
+type dbApp struct {
+	ID           int    `db:"id"`
+	Address      string `db:"address"`
+	Height       int    `db:"height"`
+	Jailed       bool   `db:"jailed"`
+	PublicKey    string `db:"public_key"`
+	StakedTokens string `db:"staked_tokens"`
+}
+
+func (a *dbApp) toIndexerApp() *indexerlib.App {
+	stakedTokens, _ := new(big.Int).SetString(a.StakedTokens, 10)
+
+	return &indexerlib.App{
+		Address:      a.Address,
+		Height:       a.Height,
+		Jailed:       a.Jailed,
+		PublicKey:    a.PublicKey,
+		StakedTokens: stakedTokens,
+	}
+}
+
+// ReadAppsPage returns a keyset page of the apps at the height by address and whether there are more past it,
+// the apps of the max height saved when height is 0
+func (d *PostgresDriver) ReadAppsPage(height int, options *PageOptions) ([]*indexerlib.App, bool, error) {
+	query := &pageQuery{table: "apps", key: []string{"address"}, cursorKey: addressCursorKey}
+	query.whereListHeight(height)
+
+	var dbApps []*dbApp
+
+	script, args := query.build(options)
+
+	err := d.Select(&dbApps, d.Rebind(script), args...)
+	if err != nil {
+		return nil, false, err
+	}
+
+	indexes, hasMore := getPageIndexes(len(dbApps), options)
+	apps := make([]*indexerlib.App, 0, len(indexes))
+
+	for _, i := range indexes {
+		apps = append(apps, dbApps[i].toIndexerApp())
+	}
+
+	return apps, hasMore, nil
+}
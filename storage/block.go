@@ -0,0 +1,71 @@
+package storage
+
+import (
+	"time"
+
+	"github.com/lib/pq"
+	indexerlib "github.com/pokt-foundation/pocket-indexer-lib"
+)
+
+const selectBlocksByHeightsScript = "SELECT * FROM blocks WHERE height = ANY($1)"
+
+// dbBlock struct handler for a row of the blocks table saved by the indexer
+type dbBlock struct {
+	ID              int       `db:"id"`
+	Hash            string    `db:"hash"`
+	Height          int       `db:"height"`
+	Time            time.Time `db:"time"`
+	ProposerAddress string    `db:"proposer_address"`
+	TXCount         int       `db:"tx_count"`
+}
+
+func (b *dbBlock) toIndexerBlock() *indexerlib.Block {
+	return &indexerlib.Block{
+		Hash:            b.Hash,
+		Height:          b.Height,
+		Time:            b.Time,
+		ProposerAddress: b.ProposerAddress,
+		TXCount:         b.TXCount,
+	}
+}
+
+// ReadBlocksPage returns a keyset page of the blocks by height and whether there are more past it
+func (d *PostgresDriver) ReadBlocksPage(options *PageOptions) ([]*indexerlib.Block, bool, error) {
+	query := &pageQuery{table: "blocks", key: []string{"height"}, cursorKey: heightCursorKey}
+
+	var dbBlocks []*dbBlock
+
+	script, args := query.build(options)
+
+	err := d.Select(&dbBlocks, d.Rebind(script), args...)
+	if err != nil {
+		return nil, false, err
+	}
+
+	indexes, hasMore := getPageIndexes(len(dbBlocks), options)
+	blocks := make([]*indexerlib.Block, 0, len(indexes))
+
+	for _, i := range indexes {
+		blocks = append(blocks, dbBlocks[i].toIndexerBlock())
+	}
+
+	return blocks, hasMore, nil
+}
+
+// ReadBlocksByHeights returns the blocks saved of the heights, in no particular order
+func (d *PostgresDriver) ReadBlocksByHeights(heights []int) ([]*indexerlib.Block, error) {
+	var dbBlocks []*dbBlock
+
+	err := d.Select(&dbBlocks, selectBlocksByHeightsScript, pq.Array(heights))
+	if err != nil {
+		return nil, err
+	}
+
+	blocks := make([]*indexerlib.Block, 0, len(dbBlocks))
+
+	for _, dbBlock := range dbBlocks {
+		blocks = append(blocks, dbBlock.toIndexerBlock())
+	}
+
+	return blocks, nil
+}
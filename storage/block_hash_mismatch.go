@@ -0,0 +1,83 @@
+package storage
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/lib/pq"
+)
+
+const (
+	createBlockHashMismatchesTableScript = `
+	CREATE TABLE IF NOT EXISTS block_hash_mismatches (
+		id SERIAL PRIMARY KEY,
+		height INT NOT NULL,
+		stored_hash TEXT NOT NULL,
+		canonical_hash TEXT NOT NULL,
+		detected_at TIMESTAMP NOT NULL DEFAULT NOW()
+	)`
+	insertBlockHashMismatchScript = `
+	INSERT into block_hash_mismatches (height, stored_hash, canonical_hash)
+	VALUES (:height, :stored_hash, :canonical_hash)`
+	deleteFromTableByHeightScript     = "DELETE FROM %s WHERE height = $1"
+	deleteFailedHeightsByHeightScript = "DELETE FROM failed_heights WHERE height = $1 AND stage = ANY($2)"
+)
+
+// stageTables are the tables of each stage cleaned by DeleteHeight, every one of them has a height column
+var stageTables = map[string]string{
+	StageBlock:        "blocks",
+	StageTransactions: "transactions",
+	StageNodes:        "nodes",
+	StageApps:         "apps",
+	StageAccounts:     "accounts",
+}
+
+// BlockHashMismatch struct handler for a saved block whose hash differs from the one in the chain
+type BlockHashMismatch struct {
+	ID            int       `db:"id"`
+	Height        int       `db:"height"`
+	StoredHash    string    `db:"stored_hash"`
+	CanonicalHash string    `db:"canonical_hash"`
+	DetectedAt    time.Time `db:"detected_at"`
+}
+
+// WriteBlockHashMismatch inserts given block hash mismatch to the database
+func (d *PostgresDriver) WriteBlockHashMismatch(mismatch *BlockHashMismatch) error {
+	_, err := d.NamedExec(insertBlockHashMismatchScript, mismatch)
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// DeleteHeight removes in a single transaction everything given stages saved at given height,
+// their failed heights included, so they can be indexed again from scratch
+func (d *PostgresDriver) DeleteHeight(height int, stages []string) error {
+	tx, err := d.Beginx()
+	if err != nil {
+		return err
+	}
+
+	for _, stage := range stages {
+		table, ok := stageTables[stage]
+		if !ok {
+			_ = tx.Rollback()
+			return ErrUnknownStage
+		}
+
+		_, err = tx.Exec(fmt.Sprintf(deleteFromTableByHeightScript, table), height)
+		if err != nil {
+			_ = tx.Rollback()
+			return err
+		}
+	}
+
+	_, err = tx.Exec(deleteFailedHeightsByHeightScript, height, pq.Array(stages))
+	if err != nil {
+		_ = tx.Rollback()
+		return err
+	}
+
+	return tx.Commit()
+}
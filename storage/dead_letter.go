@@ -0,0 +1,113 @@
+package storage
+
+import (
+	"database/sql"
+	"errors"
+	"time"
+)
+
+const (
+	createDeadLettersTableScript = `
+	CREATE TABLE IF NOT EXISTS dead_letters (
+		id SERIAL PRIMARY KEY,
+		height INT NOT NULL,
+		stage TEXT NOT NULL,
+		address TEXT NOT NULL DEFAULT '',
+		account_type TEXT NOT NULL DEFAULT '',
+		node TEXT NOT NULL DEFAULT '',
+		last_error TEXT NOT NULL,
+		attempts INT NOT NULL,
+		created_at TIMESTAMP NOT NULL DEFAULT NOW(),
+		updated_at TIMESTAMP NOT NULL DEFAULT NOW(),
+		UNIQUE (height, stage, address)
+	)`
+	upsertDeadLetterScript = `
+	INSERT into dead_letters (height, stage, address, account_type, node, last_error, attempts)
+	VALUES (:height, :stage, :address, :account_type, :node, :last_error, :attempts)
+	ON CONFLICT (height, stage, address) DO UPDATE SET
+		node = EXCLUDED.node,
+		last_error = EXCLUDED.last_error,
+		attempts = EXCLUDED.attempts,
+		updated_at = NOW()`
+	selectDeadLettersScript = "SELECT * FROM dead_letters ORDER BY height, stage, address LIMIT $1"
+	selectDeadLetterScript  = "SELECT * FROM dead_letters WHERE id = $1"
+	deleteDeadLetterScript  = "DELETE FROM dead_letters WHERE id = $1"
+)
+
+// ErrDeadLetterNotFound error when there is no dead letter with the given id
+var ErrDeadLetterNotFound = errors.New("dead letter not found")
+
+// DeadLetter struct handler for a failed height that ran out of attempts, Node is the node of its last failed call
+// Address and AccountType are only set for the accounts stage
+type DeadLetter struct {
+	ID          int       `db:"id" json:"id"`
+	Height      int       `db:"height" json:"height"`
+	Stage       string    `db:"stage" json:"stage"`
+	Address     string    `db:"address" json:"address,omitempty"`
+	AccountType string    `db:"account_type" json:"accountType,omitempty"`
+	Node        string    `db:"node" json:"node,omitempty"`
+	LastError   string    `db:"last_error" json:"lastError"`
+	Attempts    int       `db:"attempts" json:"attempts"`
+	CreatedAt   time.Time `db:"created_at" json:"createdAt"`
+	UpdatedAt   time.Time `db:"updated_at" json:"updatedAt"`
+}
+
+// WriteDeadLetter records a dead letter or, if it was already recorded, updates its last failure
+func (d *PostgresDriver) WriteDeadLetter(deadLetter *DeadLetter) error {
+	_, err := d.NamedExec(upsertDeadLetterScript, deadLetter)
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// ReadDeadLetters returns up to limit dead letters, lower heights first
+func (d *PostgresDriver) ReadDeadLetters(limit int) ([]*DeadLetter, error) {
+	var deadLetters []*DeadLetter
+
+	err := d.Select(&deadLetters, selectDeadLettersScript, limit)
+	if err != nil {
+		return nil, err
+	}
+
+	return deadLetters, nil
+}
+
+// ReadDeadLetter returns the dead letter with given id
+func (d *PostgresDriver) ReadDeadLetter(id int) (*DeadLetter, error) {
+	var deadLetter DeadLetter
+
+	err := d.Get(&deadLetter, selectDeadLetterScript, id)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, ErrDeadLetterNotFound
+		}
+
+		return nil, err
+	}
+
+	return &deadLetter, nil
+}
+
+// DeleteDeadLetter removes in a single transaction a dead letter and its poisoned failed height once it was replayed
+func (d *PostgresDriver) DeleteDeadLetter(deadLetter *DeadLetter) error {
+	tx, err := d.Beginx()
+	if err != nil {
+		return err
+	}
+
+	_, err = tx.Exec(deleteDeadLetterScript, deadLetter.ID)
+	if err != nil {
+		_ = tx.Rollback()
+		return err
+	}
+
+	_, err = tx.Exec(deleteFailedHeightScript, deadLetter.Height, deadLetter.Stage, deadLetter.Address)
+	if err != nil {
+		_ = tx.Rollback()
+		return err
+	}
+
+	return tx.Commit()
+}
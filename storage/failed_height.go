@@ -0,0 +1,143 @@
+package storage
+
+import (
+	"time"
+
+	"github.com/lib/pq"
+)
+
+const (
+	createFailedHeightsTableScript = `
+	CREATE TABLE IF NOT EXISTS failed_heights (
+		id SERIAL PRIMARY KEY,
+		height INT NOT NULL,
+		stage TEXT NOT NULL,
+		address TEXT NOT NULL DEFAULT '',
+		account_type TEXT NOT NULL DEFAULT '',
+		attempts INT NOT NULL DEFAULT 1,
+		last_error TEXT NOT NULL,
+		poisoned BOOLEAN NOT NULL DEFAULT FALSE,
+		next_retry_at TIMESTAMP NOT NULL,
+		created_at TIMESTAMP NOT NULL DEFAULT NOW(),
+		updated_at TIMESTAMP NOT NULL DEFAULT NOW(),
+		UNIQUE (height, stage, address)
+	)`
+	createFailedHeightsIndexScript = `
+	CREATE INDEX IF NOT EXISTS failed_heights_next_retry_at_idx ON failed_heights (next_retry_at) WHERE NOT poisoned`
+	upsertFailedHeightScript = `
+	INSERT into failed_heights (height, stage, address, account_type, last_error, poisoned, next_retry_at)
+	VALUES ($1, $2, $3, $4, $5, $8 <= 1, NOW() + $6::bigint * INTERVAL '1 millisecond')
+	ON CONFLICT (height, stage, address) DO UPDATE SET
+		attempts = failed_heights.attempts + 1,
+		last_error = EXCLUDED.last_error,
+		poisoned = failed_heights.attempts + 1 >= $8,
+		next_retry_at = NOW() + LEAST($6::bigint * POWER(2, failed_heights.attempts), $7::bigint) * INTERVAL '1 millisecond',
+		updated_at = NOW()
+	RETURNING *`
+	claimRetryableFailedHeightsScript = `
+	WITH claimed AS (
+		UPDATE failed_heights SET next_retry_at = NOW() + $3::bigint * INTERVAL '1 millisecond'
+		WHERE id IN (
+			SELECT id FROM failed_heights WHERE NOT poisoned AND next_retry_at <= NOW() AND stage = ANY($2)
+			ORDER BY height LIMIT $1 FOR UPDATE SKIP LOCKED
+		)
+		RETURNING *
+	)
+	SELECT * FROM claimed ORDER BY height`
+	selectFailedHeightsFromHeightScript = "SELECT * FROM failed_heights WHERE height >= $1 ORDER BY height"
+	countFailedHeightsScript            = `
+	SELECT COUNT(*) FILTER (WHERE NOT poisoned) AS retryable, COUNT(*) FILTER (WHERE poisoned) AS poisoned FROM failed_heights`
+	deleteFailedHeightScript = "DELETE FROM failed_heights WHERE height = $1 AND stage = $2 AND address = $3"
+)
+
+// FailedHeight struct handler for a height whose stage could not be indexed
+// Address and AccountType are only set for the accounts stage
+type FailedHeight struct {
+	ID          int       `db:"id"`
+	Height      int       `db:"height"`
+	Stage       string    `db:"stage"`
+	Address     string    `db:"address"`
+	AccountType string    `db:"account_type"`
+	Attempts    int       `db:"attempts"`
+	LastError   string    `db:"last_error"`
+	Poisoned    bool      `db:"poisoned"`
+	NextRetryAt time.Time `db:"next_retry_at"`
+	CreatedAt   time.Time `db:"created_at"`
+	UpdatedAt   time.Time `db:"updated_at"`
+}
+
+// WriteFailedHeightOptions parameters for the retry schedule of WriteFailedHeight
+type WriteFailedHeightOptions struct {
+	RetryInterval    time.Duration
+	MaxRetryInterval time.Duration
+	MaxAttempts      int
+}
+
+// WriteFailedHeight records a failed height or, if it was already recorded, increases its attempts
+// and doubles its retry interval up to the maximum, marking it as poisoned once max attempts are reached
+// The failed height is returned as recorded, with its attempts and whether it was poisoned
+func (d *PostgresDriver) WriteFailedHeight(failedHeight *FailedHeight, options *WriteFailedHeightOptions) (*FailedHeight, error) {
+	var recorded FailedHeight
+
+	err := d.Get(&recorded, upsertFailedHeightScript, failedHeight.Height, failedHeight.Stage, failedHeight.Address,
+		failedHeight.AccountType, failedHeight.LastError, options.RetryInterval.Milliseconds(),
+		options.MaxRetryInterval.Milliseconds(), options.MaxAttempts)
+	if err != nil {
+		return nil, err
+	}
+
+	return &recorded, nil
+}
+
+// ClaimRetryableFailedHeights returns the non poisoned failed heights of given stages due for a retry, lower heights first,
+// putting their next retry off by the claim duration so the services sharing the database don't retry them at once
+func (d *PostgresDriver) ClaimRetryableFailedHeights(limit int, stages []string, claimDuration time.Duration) ([]*FailedHeight, error) {
+	var failedHeights []*FailedHeight
+
+	err := d.Select(&failedHeights, claimRetryableFailedHeightsScript, limit, pq.Array(stages), claimDuration.Milliseconds())
+	if err != nil {
+		return nil, err
+	}
+
+	return failedHeights, nil
+}
+
+// ReadFailedHeightsFromHeight returns every failed height from given height, poisoned ones included
+func (d *PostgresDriver) ReadFailedHeightsFromHeight(fromHeight int) ([]*FailedHeight, error) {
+	var failedHeights []*FailedHeight
+
+	err := d.Select(&failedHeights, selectFailedHeightsFromHeightScript, fromHeight)
+	if err != nil {
+		return nil, err
+	}
+
+	return failedHeights, nil
+}
+
+// FailedHeightsCount struct handler for the number of failed heights still retried and the poisoned ones
+type FailedHeightsCount struct {
+	Retryable int `db:"retryable" json:"retryable"`
+	Poisoned  int `db:"poisoned" json:"poisoned"`
+}
+
+// CountFailedHeights returns the number of failed heights still retried and poisoned
+func (d *PostgresDriver) CountFailedHeights() (*FailedHeightsCount, error) {
+	var count FailedHeightsCount
+
+	err := d.Get(&count, countFailedHeightsScript)
+	if err != nil {
+		return nil, err
+	}
+
+	return &count, nil
+}
+
+// DeleteFailedHeight removes a failed height once it was indexed
+func (d *PostgresDriver) DeleteFailedHeight(failedHeight *FailedHeight) error {
+	_, err := d.Exec(deleteFailedHeightScript, failedHeight.Height, failedHeight.Stage, failedHeight.Address)
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
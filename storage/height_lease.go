@@ -0,0 +1,156 @@
+package storage
+
+import (
+	"database/sql"
+	"errors"
+	"time"
+
+	"github.com/jmoiron/sqlx"
+)
+
+const (
+	// heightLeasesLockKey is the key of the advisory lock serializing the claims of new ranges
+	heightLeasesLockKey = 7140019
+
+	createHeightLeasesTableScript = `
+	CREATE TABLE IF NOT EXISTS height_leases (
+		from_height INT PRIMARY KEY,
+		to_height INT NOT NULL,
+		owner TEXT NOT NULL,
+		claims INT NOT NULL DEFAULT 1,
+		completed BOOLEAN NOT NULL DEFAULT FALSE,
+		expires_at TIMESTAMP NOT NULL,
+		created_at TIMESTAMP NOT NULL DEFAULT NOW(),
+		updated_at TIMESTAMP NOT NULL DEFAULT NOW()
+	)`
+	createHeightLeasesIndexScript = `
+	CREATE INDEX IF NOT EXISTS height_leases_expires_at_idx ON height_leases (expires_at) WHERE NOT completed`
+	lockHeightLeasesScript   = "SELECT pg_advisory_xact_lock($1)"
+	reclaimHeightLeaseScript = `
+	UPDATE height_leases SET
+		owner = $1,
+		claims = claims + 1,
+		expires_at = NOW() + $2::bigint * INTERVAL '1 millisecond',
+		updated_at = NOW()
+	WHERE from_height = (
+		SELECT from_height FROM height_leases WHERE NOT completed AND expires_at <= NOW() ORDER BY from_height LIMIT 1
+		FOR UPDATE SKIP LOCKED
+	)
+	RETURNING *`
+	insertHeightLeaseScript = `
+	INSERT into height_leases (from_height, to_height, owner, expires_at)
+	SELECT next.height, LEAST(next.height + $4 - 1, $5), $1, NOW() + $2::bigint * INTERVAL '1 millisecond'
+	FROM (SELECT GREATEST(COALESCE(MAX(to_height), 0) + 1, $3) AS height FROM height_leases) AS next
+	WHERE next.height <= $5
+	RETURNING *`
+	renewHeightLeaseScript = `
+	UPDATE height_leases SET expires_at = NOW() + $3::bigint * INTERVAL '1 millisecond', updated_at = NOW()
+	WHERE from_height = $1 AND owner = $2 AND NOT completed`
+	completeHeightLeaseScript = `
+	UPDATE height_leases SET completed = TRUE, updated_at = NOW() WHERE from_height = $1 AND owner = $2 AND NOT completed`
+	releaseHeightLeaseScript = `
+	UPDATE height_leases SET expires_at = NOW(), updated_at = NOW() WHERE from_height = $1 AND owner = $2 AND NOT completed`
+)
+
+var (
+	// ErrNoHeightLease error when every height up to the max height is already leased
+	ErrNoHeightLease = errors.New("no height range left to lease")
+	// ErrHeightLeaseLost error when the lease is no longer owned, it expired and was reclaimed by another owner
+	ErrHeightLeaseLost = errors.New("height lease lost")
+)
+
+// HeightLease struct handler for a range of heights claimed by an owner until it expires,
+// Claims counts the owners it had, more than one means it was reclaimed and may be partially indexed
+type HeightLease struct {
+	FromHeight int       `db:"from_height"`
+	ToHeight   int       `db:"to_height"`
+	Owner      string    `db:"owner"`
+	Claims     int       `db:"claims"`
+	Completed  bool      `db:"completed"`
+	ExpiresAt  time.Time `db:"expires_at"`
+	CreatedAt  time.Time `db:"created_at"`
+	UpdatedAt  time.Time `db:"updated_at"`
+}
+
+// ClaimHeightLeaseOptions parameters for ClaimHeightLease, new ranges start from FirstHeight at least
+// and never go over MaxHeight
+type ClaimHeightLeaseOptions struct {
+	Owner       string
+	FirstHeight int
+	MaxHeight   int
+	Size        int
+	Duration    time.Duration
+}
+
+// ClaimHeightLease leases to the owner the lowest expired range not completed or, when there is none,
+// a new range of up to size heights after the last leased one
+// Expired ranges locked by another claim are skipped, the new ranges are serialized by an advisory lock
+// so two owners never get the same range
+func (d *PostgresDriver) ClaimHeightLease(options *ClaimHeightLeaseOptions) (*HeightLease, error) {
+	tx, err := d.Beginx()
+	if err != nil {
+		return nil, err
+	}
+
+	var lease HeightLease
+
+	err = tx.Get(&lease, reclaimHeightLeaseScript, options.Owner, options.Duration.Milliseconds())
+	if errors.Is(err, sql.ErrNoRows) {
+		err = claimNewHeightLease(tx, &lease, options)
+	}
+
+	if err != nil {
+		_ = tx.Rollback()
+
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, ErrNoHeightLease
+		}
+
+		return nil, err
+	}
+
+	return &lease, tx.Commit()
+}
+
+func claimNewHeightLease(tx *sqlx.Tx, lease *HeightLease, options *ClaimHeightLeaseOptions) error {
+	_, err := tx.Exec(lockHeightLeasesScript, heightLeasesLockKey)
+	if err != nil {
+		return err
+	}
+
+	return tx.Get(lease, insertHeightLeaseScript, options.Owner, options.Duration.Milliseconds(),
+		options.FirstHeight, options.Size, options.MaxHeight)
+}
+
+// RenewHeightLease extends the lease of the owner by given duration from now
+func (d *PostgresDriver) RenewHeightLease(lease *HeightLease, duration time.Duration) error {
+	return d.updateHeightLease(renewHeightLeaseScript, lease.FromHeight, lease.Owner, duration.Milliseconds())
+}
+
+// CompleteHeightLease marks the lease as completed so its range is never leased again
+func (d *PostgresDriver) CompleteHeightLease(lease *HeightLease) error {
+	return d.updateHeightLease(completeHeightLeaseScript, lease.FromHeight, lease.Owner)
+}
+
+// ReleaseHeightLease expires the lease right away so another owner can reclaim its range
+func (d *PostgresDriver) ReleaseHeightLease(lease *HeightLease) error {
+	return d.updateHeightLease(releaseHeightLeaseScript, lease.FromHeight, lease.Owner)
+}
+
+func (d *PostgresDriver) updateHeightLease(script string, args ...interface{}) error {
+	result, err := d.Exec(script, args...)
+	if err != nil {
+		return err
+	}
+
+	rows, err := result.RowsAffected()
+	if err != nil {
+		return err
+	}
+
+	if rows == 0 {
+		return ErrHeightLeaseLost
+	}
+
+	return nil
+}
@@ -0,0 +1,132 @@
+package storage
+
+import (
+	"errors"
+	"regexp"
+	"testing"
+	"time"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/stretchr/testify/require"
+)
+
+var heightLeaseColumns = []string{"from_height", "to_height", "owner", "claims", "completed", "expires_at", "created_at", "updated_at"}
+
+func TestPostgresDriver_ClaimHeightLease(t *testing.T) {
+	options := &ClaimHeightLeaseOptions{
+		Owner:       "replica-1",
+		FirstHeight: 1,
+		MaxHeight:   5000,
+		Size:        1000,
+		Duration:    time.Minute,
+	}
+	now := time.Now()
+
+	tests := []struct {
+		name   string
+		expect func(mock sqlmock.Sqlmock)
+		lease  *HeightLease
+		err    error
+	}{
+		{
+			name: "expired range reclaimed",
+			expect: func(mock sqlmock.Sqlmock) {
+				mock.ExpectBegin()
+				mock.ExpectQuery(regexp.QuoteMeta("FOR UPDATE SKIP LOCKED")).WithArgs("replica-1", int64(60000)).
+					WillReturnRows(sqlmock.NewRows(heightLeaseColumns).AddRow(1001, 2000, "replica-1", 2, false, now, now, now))
+				mock.ExpectCommit()
+			},
+			lease: &HeightLease{FromHeight: 1001, ToHeight: 2000, Owner: "replica-1", Claims: 2, ExpiresAt: now, CreatedAt: now, UpdatedAt: now},
+		},
+		{
+			name: "new range after the last leased one",
+			expect: func(mock sqlmock.Sqlmock) {
+				mock.ExpectBegin()
+				mock.ExpectQuery(regexp.QuoteMeta("FOR UPDATE SKIP LOCKED")).WithArgs("replica-1", int64(60000)).
+					WillReturnRows(sqlmock.NewRows(heightLeaseColumns))
+				mock.ExpectExec(regexp.QuoteMeta("SELECT pg_advisory_xact_lock($1)")).WithArgs(heightLeasesLockKey).
+					WillReturnResult(sqlmock.NewResult(0, 0))
+				mock.ExpectQuery("INSERT into height_leases").WithArgs("replica-1", int64(60000), 1, 1000, 5000).
+					WillReturnRows(sqlmock.NewRows(heightLeaseColumns).AddRow(2001, 3000, "replica-1", 1, false, now, now, now))
+				mock.ExpectCommit()
+			},
+			lease: &HeightLease{FromHeight: 2001, ToHeight: 3000, Owner: "replica-1", Claims: 1, ExpiresAt: now, CreatedAt: now, UpdatedAt: now},
+		},
+		{
+			name: "every height leased",
+			expect: func(mock sqlmock.Sqlmock) {
+				mock.ExpectBegin()
+				mock.ExpectQuery(regexp.QuoteMeta("FOR UPDATE SKIP LOCKED")).
+					WillReturnRows(sqlmock.NewRows(heightLeaseColumns))
+				mock.ExpectExec(regexp.QuoteMeta("SELECT pg_advisory_xact_lock($1)")).
+					WillReturnResult(sqlmock.NewResult(0, 0))
+				mock.ExpectQuery("INSERT into height_leases").
+					WillReturnRows(sqlmock.NewRows(heightLeaseColumns))
+				mock.ExpectRollback()
+			},
+			err: ErrNoHeightLease,
+		},
+		{
+			name: "reclaim error",
+			expect: func(mock sqlmock.Sqlmock) {
+				mock.ExpectBegin()
+				mock.ExpectQuery(regexp.QuoteMeta("FOR UPDATE SKIP LOCKED")).
+					WillReturnError(errors.New("dummy error"))
+				mock.ExpectRollback()
+			},
+			err: errors.New("dummy error"),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			c := require.New(t)
+
+			db, mock, err := sqlmock.New()
+			c.NoError(err)
+
+			defer db.Close()
+
+			tt.expect(mock)
+
+			driver := NewPostgresDriverFromSQLDBInstance(db)
+
+			lease, err := driver.ClaimHeightLease(options)
+
+			c.Equal(tt.err, err)
+			c.Equal(tt.lease, lease)
+			c.NoError(mock.ExpectationsWereMet())
+		})
+	}
+}
+
+func TestPostgresDriver_RenewHeightLease(t *testing.T) {
+	c := require.New(t)
+
+	db, mock, err := sqlmock.New()
+	c.NoError(err)
+
+	defer db.Close()
+
+	lease := &HeightLease{FromHeight: 1001, Owner: "replica-1"}
+
+	mock.ExpectExec("UPDATE height_leases SET expires_at").WithArgs(1001, "replica-1", int64(60000)).
+		WillReturnResult(sqlmock.NewResult(0, 1))
+
+	driver := NewPostgresDriverFromSQLDBInstance(db)
+
+	c.NoError(driver.RenewHeightLease(lease, time.Minute))
+
+	// The lease expired and was reclaimed by another owner
+	mock.ExpectExec("UPDATE height_leases SET expires_at").WithArgs(1001, "replica-1", int64(60000)).
+		WillReturnResult(sqlmock.NewResult(0, 0))
+
+	c.Equal(ErrHeightLeaseLost, driver.RenewHeightLease(lease, time.Minute))
+
+	mock.ExpectExec("UPDATE height_leases SET completed").WithArgs(1001, "replica-1").
+		WillReturnError(errors.New("dummy error"))
+
+	c.EqualError(driver.CompleteHeightLease(lease), "dummy error")
+
+	c.NoError(mock.ExpectationsWereMet())
+}
@@ -0,0 +1,96 @@
+package storage
+
+import (
+	"context"
+	"database/sql"
+	"database/sql/driver"
+	"errors"
+)
+
+const (
+	tryLeaderLockScript     = "SELECT pg_try_advisory_lock(hashtext($1))"
+	releaseLeaderLockScript = "SELECT pg_advisory_unlock(hashtext($1))"
+	// A bigint advisory key is kept in pg_locks with its high half as classid, its low half as objid and objsubid 1
+	checkLeaderLockScript = `
+	SELECT EXISTS (SELECT 1 FROM pg_locks
+	WHERE locktype = 'advisory' AND pid = pg_backend_pid() AND granted
+	AND classid = ((hashtext($1)::bigint >> 32) & 4294967295)::oid
+	AND objid = hashtext($1)::oid
+	AND objsubid = 1)`
+)
+
+var (
+	// ErrLeaderLockHeld error when the leader lock is held by another session
+	ErrLeaderLockHeld = errors.New("leader lock held by another session")
+	// ErrLeaderLockLost error when the session holding the leader lock no longer does
+	ErrLeaderLockLost = errors.New("leader lock lost")
+)
+
+// LeaderLock struct handler for a session advisory lock held on a connection of its own,
+// Postgres releases it when the connection closes so a crashed leader never keeps it
+type LeaderLock struct {
+	name string
+	conn *sql.Conn
+}
+
+// TryLeaderLock takes the advisory lock with given name without waiting for it
+func (d *PostgresDriver) TryLeaderLock(ctx context.Context, name string) (*LeaderLock, error) {
+	conn, err := d.Conn(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	var locked bool
+
+	err = conn.QueryRowContext(ctx, tryLeaderLockScript, name).Scan(&locked)
+	if err != nil {
+		_ = conn.Close()
+		return nil, err
+	}
+
+	if !locked {
+		_ = conn.Close()
+		return nil, ErrLeaderLockHeld
+	}
+
+	return &LeaderLock{name: name, conn: conn}, nil
+}
+
+// Check returns an error when the lock is no longer held, its connection failing included,
+// only the advisory lock of its name counts so other advisory locks of the session do not hide its loss
+func (l *LeaderLock) Check(ctx context.Context) error {
+	var held bool
+
+	err := l.conn.QueryRowContext(ctx, checkLeaderLockScript, l.name).Scan(&held)
+	if err != nil {
+		return err
+	}
+
+	if !held {
+		return ErrLeaderLockLost
+	}
+
+	return nil
+}
+
+// Release unlocks the lock and returns its connection to the pool, when the unlock fails the connection
+// is discarded instead so its session ends and Postgres releases the lock with it
+func (l *LeaderLock) Release() error {
+	_, err := l.conn.ExecContext(context.Background(), releaseLeaderLockScript, l.name)
+	if err != nil {
+		discardConn(l.conn)
+		return err
+	}
+
+	return l.conn.Close()
+}
+
+// discardConn closes the connection out of the pool, a driver.ErrBadConn returned from Raw
+// makes database/sql close the driver connection instead of reusing it
+func discardConn(conn *sql.Conn) {
+	_ = conn.Raw(func(interface{}) error {
+		return driver.ErrBadConn
+	})
+
+	_ = conn.Close()
+}
@@ -0,0 +1,52 @@
+package storage
+
+import (
+	"context"
+	"regexp"
+	"testing"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestPostgresDriver_TryLeaderLock(t *testing.T) {
+	c := require.New(t)
+
+	db, mock, err := sqlmock.New()
+	c.NoError(err)
+
+	defer db.Close()
+
+	driver := NewPostgresDriverFromSQLDBInstance(db)
+
+	mock.ExpectQuery(regexp.QuoteMeta(tryLeaderLockScript)).WithArgs("leader").
+		WillReturnRows(sqlmock.NewRows([]string{"pg_try_advisory_lock"}).AddRow(false))
+
+	lock, err := driver.TryLeaderLock(context.Background(), "leader")
+	c.Equal(ErrLeaderLockHeld, err)
+	c.Nil(lock)
+
+	mock.ExpectQuery(regexp.QuoteMeta(tryLeaderLockScript)).WithArgs("leader").
+		WillReturnRows(sqlmock.NewRows([]string{"pg_try_advisory_lock"}).AddRow(true))
+
+	lock, err = driver.TryLeaderLock(context.Background(), "leader")
+	c.NoError(err)
+
+	// The check only counts the advisory lock of the leader key
+	mock.ExpectQuery(regexp.QuoteMeta("AND objid = hashtext($1)::oid")).WithArgs("leader").
+		WillReturnRows(sqlmock.NewRows([]string{"exists"}).AddRow(true))
+
+	c.NoError(lock.Check(context.Background()))
+
+	mock.ExpectQuery(regexp.QuoteMeta("AND objid = hashtext($1)::oid")).WithArgs("leader").
+		WillReturnRows(sqlmock.NewRows([]string{"exists"}).AddRow(false))
+
+	c.Equal(ErrLeaderLockLost, lock.Check(context.Background()))
+
+	mock.ExpectExec(regexp.QuoteMeta(releaseLeaderLockScript)).WithArgs("leader").
+		WillReturnResult(sqlmock.NewResult(0, 0))
+
+	c.NoError(lock.Release())
+
+	c.NoError(mock.ExpectationsWereMet())
+}
@@ -0,0 +1,107 @@
+package storage
+
+const (
+	selectMissingHeightsScript = `
+	WITH heights AS (SELECT generate_series($1::int, $2::int) AS height)
+	SELECT height, stage FROM (
+		SELECT h.height, 'block' AS stage FROM heights h
+		WHERE NOT EXISTS (SELECT 1 FROM blocks b WHERE b.height = h.height)
+		UNION
+		SELECT h.height, 'transactions' AS stage FROM heights h LEFT JOIN blocks b ON b.height = h.height
+		WHERE (b.height IS NULL OR b.tx_count > 0) AND NOT EXISTS (SELECT 1 FROM transactions t WHERE t.height = h.height)
+		UNION
+		SELECT h.height, 'nodes' AS stage FROM heights h
+		WHERE NOT EXISTS (SELECT 1 FROM nodes n WHERE n.height = h.height)
+		UNION
+		SELECT h.height, 'apps' AS stage FROM heights h
+		WHERE NOT EXISTS (SELECT 1 FROM apps a WHERE a.height = h.height)
+		UNION
+		SELECT n.height, 'accounts' AS stage FROM nodes n
+		WHERE n.height BETWEEN $1 AND $2 AND NOT EXISTS (
+			SELECT 1 FROM accounts a WHERE a.height = n.height AND a.address = n.address AND a.account_type = 'node'
+		)
+		UNION
+		SELECT p.height, 'accounts' AS stage FROM apps p
+		WHERE p.height BETWEEN $1 AND $2 AND NOT EXISTS (
+			SELECT 1 FROM accounts a WHERE a.height = p.height AND a.address = p.address AND a.account_type = 'app'
+		)
+		UNION
+		SELECT t.height, 'accounts' AS stage FROM transactions t
+		CROSS JOIN LATERAL (VALUES (t.from_address), (t.to_address)) AS tx(address)
+		WHERE t.height BETWEEN $1 AND $2 AND tx.address <> '' AND NOT EXISTS (
+			SELECT 1 FROM accounts a WHERE a.height = t.height AND a.address = tx.address AND a.account_type = 'wallet'
+		)
+	) missing
+	WHERE NOT EXISTS (SELECT 1 FROM failed_heights f WHERE f.height = missing.height AND f.stage = missing.stage)
+	ORDER BY height`
+	selectMissingAccountsScript = `
+	SELECT n.address, 'node' AS account_type FROM nodes n
+	WHERE n.height = $1 AND NOT EXISTS (
+		SELECT 1 FROM accounts a WHERE a.height = n.height AND a.address = n.address AND a.account_type = 'node'
+	)
+	UNION
+	SELECT p.address, 'app' AS account_type FROM apps p
+	WHERE p.height = $1 AND NOT EXISTS (
+		SELECT 1 FROM accounts a WHERE a.height = p.height AND a.address = p.address AND a.account_type = 'app'
+	)
+	UNION
+	SELECT tx.address, 'wallet' AS account_type FROM transactions t
+	CROSS JOIN LATERAL (VALUES (t.from_address), (t.to_address)) AS tx(address)
+	WHERE t.height = $1 AND tx.address <> '' AND NOT EXISTS (
+		SELECT 1 FROM accounts a WHERE a.height = t.height AND a.address = tx.address AND a.account_type = 'wallet'
+	)`
+	selectTransactionAddressesScript = `
+	SELECT from_address AS address FROM transactions WHERE height = $1 AND from_address <> ''
+	UNION
+	SELECT to_address AS address FROM transactions WHERE height = $1 AND to_address <> ''`
+)
+
+// MissingHeight struct handler for a height whose stage has no rows saved
+type MissingHeight struct {
+	Height int    `db:"height"`
+	Stage  string `db:"stage"`
+}
+
+// MissingAccount struct handler for a node, app or transaction address without its account saved at a height
+type MissingAccount struct {
+	Address     string `db:"address"`
+	AccountType string `db:"account_type"`
+}
+
+// ReadMissingHeights returns the heights in the given range with a stage missing in its table,
+// leaving out the ones already recorded as failed heights
+// Transactions are only expected for blocks with txs or for blocks not saved yet
+func (d *PostgresDriver) ReadMissingHeights(fromHeight, toHeight int) ([]*MissingHeight, error) {
+	var missingHeights []*MissingHeight
+
+	err := d.Select(&missingHeights, selectMissingHeightsScript, fromHeight, toHeight)
+	if err != nil {
+		return nil, err
+	}
+
+	return missingHeights, nil
+}
+
+// ReadMissingAccounts returns the node, app and transaction addresses saved at given height that have no account saved
+func (d *PostgresDriver) ReadMissingAccounts(height int) ([]*MissingAccount, error) {
+	var missingAccounts []*MissingAccount
+
+	err := d.Select(&missingAccounts, selectMissingAccountsScript, height)
+	if err != nil {
+		return nil, err
+	}
+
+	return missingAccounts, nil
+}
+
+// ReadTransactionAddresses returns the addresses sending or receiving the transactions saved at given height
+func (d *PostgresDriver) ReadTransactionAddresses(height int) ([]string, error) {
+	var addresses []string
+
+	err := d.Select(&addresses, selectTransactionAddressesScript, height)
+	if err != nil {
+		return nil, err
+	}
+
+	return addresses, nil
+}
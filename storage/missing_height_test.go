@@ -0,0 +1,102 @@
+package storage
+
+import (
+	"errors"
+	"regexp"
+	"testing"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestPostgresDriver_ReadMissingHeights(t *testing.T) {
+	c := require.New(t)
+
+	db, mock, err := sqlmock.New()
+	c.NoError(err)
+
+	defer db.Close()
+
+	rows := sqlmock.NewRows([]string{"height", "stage"}).
+		AddRow(3, StageBlock).
+		AddRow(3, StageTransactions).
+		AddRow(7, StageAccounts)
+
+	mock.ExpectQuery(regexp.QuoteMeta("SELECT generate_series($1::int, $2::int) AS height")).WithArgs(1, 10).
+		WillReturnRows(rows)
+
+	driver := NewPostgresDriverFromSQLDBInstance(db)
+
+	missingHeights, err := driver.ReadMissingHeights(1, 10)
+	c.NoError(err)
+	c.Equal([]*MissingHeight{
+		{Height: 3, Stage: StageBlock},
+		{Height: 3, Stage: StageTransactions},
+		{Height: 7, Stage: StageAccounts},
+	}, missingHeights)
+
+	mock.ExpectQuery(regexp.QuoteMeta("SELECT generate_series($1::int, $2::int) AS height")).WithArgs(1, 10).
+		WillReturnError(errors.New("dummy error"))
+
+	missingHeights, err = driver.ReadMissingHeights(1, 10)
+	c.EqualError(err, "dummy error")
+	c.Nil(missingHeights)
+
+	c.NoError(mock.ExpectationsWereMet())
+}
+
+func TestPostgresDriver_ReadMissingAccounts(t *testing.T) {
+	c := require.New(t)
+
+	db, mock, err := sqlmock.New()
+	c.NoError(err)
+
+	defer db.Close()
+
+	// An address can miss the account of each of its types
+	rows := sqlmock.NewRows([]string{"address", "account_type"}).
+		AddRow("00353abd21ef72725b295ba5a9a5eb6082548e21", "node").
+		AddRow("00353abd21ef72725b295ba5a9a5eb6082548e21", "wallet")
+
+	mock.ExpectQuery(regexp.QuoteMeta("SELECT n.address, 'node' AS account_type FROM nodes n")).WithArgs(21).
+		WillReturnRows(rows)
+
+	driver := NewPostgresDriverFromSQLDBInstance(db)
+
+	missingAccounts, err := driver.ReadMissingAccounts(21)
+	c.NoError(err)
+	c.Equal([]*MissingAccount{
+		{Address: "00353abd21ef72725b295ba5a9a5eb6082548e21", AccountType: "node"},
+		{Address: "00353abd21ef72725b295ba5a9a5eb6082548e21", AccountType: "wallet"},
+	}, missingAccounts)
+
+	mock.ExpectQuery(regexp.QuoteMeta("SELECT n.address, 'node' AS account_type FROM nodes n")).WithArgs(21).
+		WillReturnError(errors.New("dummy error"))
+
+	missingAccounts, err = driver.ReadMissingAccounts(21)
+	c.EqualError(err, "dummy error")
+	c.Nil(missingAccounts)
+
+	c.NoError(mock.ExpectationsWereMet())
+}
+
+func TestPostgresDriver_ReadTransactionAddresses(t *testing.T) {
+	c := require.New(t)
+
+	db, mock, err := sqlmock.New()
+	c.NoError(err)
+
+	defer db.Close()
+
+	rows := sqlmock.NewRows([]string{"address"}).AddRow("a1").AddRow("b2")
+
+	mock.ExpectQuery("SELECT from_address AS address FROM transactions").WithArgs(21).WillReturnRows(rows)
+
+	driver := NewPostgresDriverFromSQLDBInstance(db)
+
+	addresses, err := driver.ReadTransactionAddresses(21)
+	c.NoError(err)
+	c.Equal([]string{"a1", "b2"}, addresses)
+
+	c.NoError(mock.ExpectationsWereMet())
+}
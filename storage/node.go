@@ -0,0 +1,56 @@
+package storage
+
+import (
+	"math/big"
+
+	indexerlib "github.com/pokt-foundation/pocket-indexer-lib"
+)
+
+// dbNode struct handler for a row of the nodes table saved by the indexer
+type dbNode struct {
+	ID         int    `db:"id"`
+	Address    string `db:"address"`
+	Height     int    `db:"height"`
+	Jailed     bool   `db:"jailed"`
+	PublicKey  string `db:"public_key"`
+	ServiceURL string `db:"service_url"`
+	Tokens     string `db:"tokens"`
+}
+
+func (n *dbNode) toIndexerNode() *indexerlib.Node {
+	tokens, _ := new(big.Int).SetString(n.Tokens, 10)
+
+	return &indexerlib.Node{
+		Address:    n.Address,
+		Height:     n.Height,
+		Jailed:     n.Jailed,
+		PublicKey:  n.PublicKey,
+		ServiceURL: n.ServiceURL,
+		Tokens:     tokens,
+	}
+}
+
+// ReadNodesPage returns a keyset page of the nodes at the height by address and whether there are more past it,
+// the nodes of the max height saved when height is 0
+func (d *PostgresDriver) ReadNodesPage(height int, options *PageOptions) ([]*indexerlib.Node, bool, error) {
+	query := &pageQuery{table: "nodes", key: []string{"address"}, cursorKey: addressCursorKey}
+	query.whereListHeight(height)
+
+	var dbNodes []*dbNode
+
+	script, args := query.build(options)
+
+	err := d.Select(&dbNodes, d.Rebind(script), args...)
+	if err != nil {
+		return nil, false, err
+	}
+
+	indexes, hasMore := getPageIndexes(len(dbNodes), options)
+	nodes := make([]*indexerlib.Node, 0, len(indexes))
+
+	for _, i := range indexes {
+		nodes = append(nodes, dbNodes[i].toIndexerNode())
+	}
+
+	return nodes, hasMore, nil
+}
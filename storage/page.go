@@ -0,0 +1,141 @@
+package storage
+
+import (
+	"fmt"
+	"strings"
+)
+
+// Cursor struct handler for the position of a row in a keyset paginated list, the height and index
+// of a block or a transaction, the height and address of a node or app, or the height, address and type of an account
+type Cursor struct {
+	Height      int
+	Index       int
+	Address     string
+	AccountType string
+}
+
+// PageOptions struct handler for a keyset page, the first Limit rows after the After cursor
+// or, when Backward is set, the last Limit rows before the Before cursor, in descending order when Descending is set
+// Unlike page offsets the rows inserted meanwhile don't shift the next pages, Offset skips rows for the lists read by page
+type PageOptions struct {
+	Limit      int
+	Offset     int
+	After      *Cursor
+	Before     *Cursor
+	Backward   bool
+	Descending bool
+}
+
+// pageQuery builds the query of a keyset page of a table, the rows filtered by the conditions added
+// and ordered by the key columns, placeholders are written as ? and rebound for Postgres
+type pageQuery struct {
+	table      string
+	key        []string
+	cursorKey  func(cursor *Cursor) []interface{}
+	conditions []string
+	args       []interface{}
+}
+
+func (q *pageQuery) where(condition string, args ...interface{}) {
+	q.conditions = append(q.conditions, condition)
+	q.args = append(q.args, args...)
+}
+
+// whereListHeight keeps the rows of a list of a single height, the max height saved when it is 0
+func (q *pageQuery) whereListHeight(height int) {
+	if height > 0 {
+		q.where("height = ?", height)
+		return
+	}
+
+	q.where(fmt.Sprintf("height = (SELECT MAX(height) FROM %s)", q.table))
+}
+
+// build returns the query of the page, reading one row more than the limit to know if there are more rows
+func (q *pageQuery) build(options *PageOptions) (string, []interface{}) {
+	afterOperator, beforeOperator := ">", "<"
+	if options.Descending {
+		afterOperator, beforeOperator = "<", ">"
+	}
+
+	if options.After != nil {
+		q.where(q.compareKey(afterOperator), q.cursorKey(options.After)...)
+	}
+
+	if options.Before != nil {
+		q.where(q.compareKey(beforeOperator), q.cursorKey(options.Before)...)
+	}
+
+	// A backward page is read in the opposite order from the before cursor and reversed afterwards
+	direction := "ASC"
+	if options.Descending != options.Backward {
+		direction = "DESC"
+	}
+
+	orderBy := make([]string, 0, len(q.key))
+	for _, column := range q.key {
+		orderBy = append(orderBy, column+" "+direction)
+	}
+
+	query := q.selectRows("*") + fmt.Sprintf(" ORDER BY %s LIMIT ? OFFSET ?", strings.Join(orderBy, ", "))
+
+	return query, append(q.args, options.Limit+1, options.Offset)
+}
+
+// count returns the query counting the rows kept by the conditions
+func (q *pageQuery) count() (string, []interface{}) {
+	return q.selectRows("COUNT(*)"), q.args
+}
+
+func (q *pageQuery) selectRows(columns string) string {
+	query := fmt.Sprintf("SELECT %s FROM %s", columns, q.table)
+	if len(q.conditions) > 0 {
+		query += " WHERE " + strings.Join(q.conditions, " AND ")
+	}
+
+	return query
+}
+
+func (q *pageQuery) compareKey(operator string) string {
+	placeholders := strings.TrimSuffix(strings.Repeat("?, ", len(q.key)), ", ")
+
+	return fmt.Sprintf("(%s) %s (%s)", strings.Join(q.key, ", "), operator, placeholders)
+}
+
+// getPageIndexes returns the indexes of the rows read that belong to the page, in the order of the page,
+// and whether there are more rows past the limit
+func getPageIndexes(rows int, options *PageOptions) ([]int, bool) {
+	hasMore := rows > options.Limit
+	if hasMore {
+		rows = options.Limit
+	}
+
+	indexes := make([]int, 0, rows)
+
+	for i := 0; i < rows; i++ {
+		if options.Backward {
+			indexes = append(indexes, rows-1-i)
+			continue
+		}
+
+		indexes = append(indexes, i)
+	}
+
+	return indexes, hasMore
+}
+
+func heightCursorKey(cursor *Cursor) []interface{} {
+	return []interface{}{cursor.Height}
+}
+
+func heightIndexCursorKey(cursor *Cursor) []interface{} {
+	return []interface{}{cursor.Height, cursor.Index}
+}
+
+func addressCursorKey(cursor *Cursor) []interface{} {
+	return []interface{}{cursor.Address}
+}
+
+func addressTypeCursorKey(cursor *Cursor) []interface{} {
+	return []interface{}{cursor.Address, cursor.AccountType}
+}
@@ -0,0 +1,146 @@
+package storage
+
+import (
+	"regexp"
+	"testing"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestGetPageIndexes(t *testing.T) {
+	tests := []struct {
+		name    string
+		rows    int
+		options *PageOptions
+		indexes []int
+		hasMore bool
+	}{
+		{name: "no rows", rows: 0, options: &PageOptions{Limit: 2}, indexes: []int{}},
+		{name: "rows under the limit", rows: 2, options: &PageOptions{Limit: 3}, indexes: []int{0, 1}},
+		{name: "extra row read", rows: 3, options: &PageOptions{Limit: 2}, indexes: []int{0, 1}, hasMore: true},
+		{name: "backward page reversed", rows: 2, options: &PageOptions{Limit: 3, Backward: true}, indexes: []int{1, 0}},
+		{
+			name:    "backward page without the extra row",
+			rows:    3,
+			options: &PageOptions{Limit: 2, Backward: true},
+			indexes: []int{1, 0},
+			hasMore: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			c := require.New(t)
+
+			indexes, hasMore := getPageIndexes(tt.rows, tt.options)
+
+			c.Equal(tt.indexes, indexes)
+			c.Equal(tt.hasMore, hasMore)
+		})
+	}
+}
+
+func TestPageQuery_Build(t *testing.T) {
+	after := &Cursor{Height: 10, Address: "a1", AccountType: "node"}
+	before := &Cursor{Height: 10, Address: "b2", AccountType: "app"}
+
+	tests := []struct {
+		name    string
+		options *PageOptions
+		query   string
+		args    []interface{}
+	}{
+		{
+			name:    "first page",
+			options: &PageOptions{Limit: 2},
+			query:   "SELECT * FROM accounts WHERE height = ? ORDER BY address ASC, account_type ASC LIMIT ? OFFSET ?",
+			args:    []interface{}{10, 3, 0},
+		},
+		{
+			name:    "page after a cursor",
+			options: &PageOptions{Limit: 2, After: after},
+			query:   "SELECT * FROM accounts WHERE height = ? AND (address, account_type) > (?, ?) ORDER BY address ASC, account_type ASC LIMIT ? OFFSET ?",
+			args:    []interface{}{10, "a1", "node", 3, 0},
+		},
+		{
+			name:    "descending page after a cursor",
+			options: &PageOptions{Limit: 2, After: after, Descending: true},
+			query:   "SELECT * FROM accounts WHERE height = ? AND (address, account_type) < (?, ?) ORDER BY address DESC, account_type DESC LIMIT ? OFFSET ?",
+			args:    []interface{}{10, "a1", "node", 3, 0},
+		},
+		{
+			name:    "backward page before a cursor",
+			options: &PageOptions{Limit: 2, Before: before, Backward: true},
+			query:   "SELECT * FROM accounts WHERE height = ? AND (address, account_type) < (?, ?) ORDER BY address DESC, account_type DESC LIMIT ? OFFSET ?",
+			args:    []interface{}{10, "b2", "app", 3, 0},
+		},
+		{
+			name:    "page between cursors with an offset",
+			options: &PageOptions{Limit: 2, Offset: 4, After: after, Before: before},
+			query:   "SELECT * FROM accounts WHERE height = ? AND (address, account_type) > (?, ?) AND (address, account_type) < (?, ?) ORDER BY address ASC, account_type ASC LIMIT ? OFFSET ?",
+			args:    []interface{}{10, "a1", "node", "b2", "app", 3, 4},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			c := require.New(t)
+
+			query := &pageQuery{table: "accounts", key: []string{"address", "account_type"}, cursorKey: addressTypeCursorKey}
+			query.whereListHeight(10)
+
+			script, args := query.build(tt.options)
+
+			c.Equal(tt.query, script)
+			c.Equal(tt.args, args)
+		})
+	}
+}
+
+func TestPageQuery_WhereListHeight(t *testing.T) {
+	c := require.New(t)
+
+	query := &pageQuery{table: "nodes", key: []string{"address"}, cursorKey: addressCursorKey}
+	query.whereListHeight(0)
+
+	script, args := query.count()
+
+	c.Equal("SELECT COUNT(*) FROM nodes WHERE height = (SELECT MAX(height) FROM nodes)", script)
+	c.Empty(args)
+}
+
+func TestPostgresDriver_ReadAccountsPage(t *testing.T) {
+	c := require.New(t)
+
+	db, mock, err := sqlmock.New()
+	c.NoError(err)
+
+	defer db.Close()
+
+	columns := []string{"id", "address", "height", "account_type", "balance", "balance_denomination"}
+
+	// The same address has an account of each type at the height, the keyset tells them apart
+	rows := sqlmock.NewRows(columns).
+		AddRow(1, "a1", 21, "node", "10", "upokt").
+		AddRow(2, "a1", 21, "app", "20", "upokt")
+
+	mock.ExpectQuery(regexp.QuoteMeta("SELECT * FROM accounts WHERE height = $1 AND (address, account_type) < ($2, $3) ORDER BY address DESC, account_type DESC LIMIT $4 OFFSET $5")).
+		WithArgs(21, "a1", "wallet", 2, 0).
+		WillReturnRows(rows)
+
+	driver := NewPostgresDriverFromSQLDBInstance(db)
+
+	accounts, hasMore, err := driver.ReadAccountsPage(21, &PageOptions{
+		Limit:    1,
+		Before:   &Cursor{Height: 21, Address: "a1", AccountType: "wallet"},
+		Backward: true,
+	})
+	c.NoError(err)
+	c.True(hasMore)
+	c.Len(accounts, 1)
+	c.Equal("node", string(accounts[0].AccountType))
+	c.Equal(int64(10), accounts[0].Balance.Int64())
+
+	c.NoError(mock.ExpectationsWereMet())
+}
@@ -0,0 +1,55 @@
+// Package storage extends the indexer lib postgres driver with the tables owned by the services
+package storage
+
+import (
+	"database/sql"
+
+	postgresdriver "github.com/pokt-foundation/pocket-indexer-lib/postgres-driver"
+)
+
+var createTablesScripts = []string{
+	createFailedHeightsTableScript,
+	createFailedHeightsIndexScript,
+	createBlockHashMismatchesTableScript,
+	createIndexingProgressTableScript,
+	createDeadLettersTableScript,
+	createHeightLeasesTableScript,
+	createHeightLeasesIndexScript,
+}
+
+// PostgresDriver struct handler for PostgresDB related functions of the services
+type PostgresDriver struct {
+	*postgresdriver.PostgresDriver
+}
+
+// NewPostgresDriverFromConnectionString returns PostgresDriver instance from connection string
+func NewPostgresDriverFromConnectionString(connectionString string) (*PostgresDriver, error) {
+	driver, err := postgresdriver.NewPostgresDriverFromConnectionString(connectionString)
+	if err != nil {
+		return nil, err
+	}
+
+	return &PostgresDriver{
+		PostgresDriver: driver,
+	}, nil
+}
+
+// NewPostgresDriverFromSQLDBInstance returns PostgresDriver instance from sql.DB instance
+// mostly used for mocking tests
+func NewPostgresDriverFromSQLDBInstance(db *sql.DB) *PostgresDriver {
+	return &PostgresDriver{
+		PostgresDriver: postgresdriver.NewPostgresDriverFromSQLDBInstance(db),
+	}
+}
+
+// CreateTables creates the tables owned by the services if they do not exist yet
+func (d *PostgresDriver) CreateTables() error {
+	for _, script := range createTablesScripts {
+		_, err := d.Exec(script)
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
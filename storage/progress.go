@@ -0,0 +1,52 @@
+package storage
+
+import (
+	"database/sql"
+	"errors"
+)
+
+const (
+	createIndexingProgressTableScript = `
+	CREATE TABLE IF NOT EXISTS indexing_progress (
+		name TEXT PRIMARY KEY,
+		height INT NOT NULL,
+		updated_at TIMESTAMP NOT NULL DEFAULT NOW()
+	)`
+	upsertProgressScript = `
+	INSERT into indexing_progress (name, height)
+	VALUES ($1, $2)
+	ON CONFLICT (name) DO UPDATE SET
+		height = EXCLUDED.height,
+		updated_at = NOW()`
+	selectProgressScript = "SELECT height FROM indexing_progress WHERE name = $1"
+)
+
+// ErrNoProgress error when there is no progress saved with the given name
+var ErrNoProgress = errors.New("no progress saved")
+
+// WriteProgress saves the height reached by the progress with given name,
+// the services save the committed height of each stage under the stage name
+func (d *PostgresDriver) WriteProgress(name string, height int) error {
+	_, err := d.Exec(upsertProgressScript, name, height)
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// ReadProgress returns the height reached by the progress with given name
+func (d *PostgresDriver) ReadProgress(name string) (int, error) {
+	var height int
+
+	err := d.Get(&height, selectProgressScript, name)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return 0, ErrNoProgress
+		}
+
+		return 0, err
+	}
+
+	return height, nil
+}
@@ -0,0 +1,22 @@
+package storage
+
+import "errors"
+
+// Stages of indexing for a height, as stored in the services tables
+const (
+	StageBlock        = "block"
+	StageTransactions = "transactions"
+	StageNodes        = "nodes"
+	StageApps         = "apps"
+	StageAccounts     = "accounts"
+)
+
+// AccountTypeWallet is the account type of the addresses taking part in transactions or snapshotted,
+// besides the node and app account types of the indexer lib
+const AccountTypeWallet = "wallet"
+
+// ErrUnknownStage error when a stage is not one of the stages of indexing
+var ErrUnknownStage = errors.New("unknown stage")
+
+// Stages are every stage of indexing in the order they are launched for a height
+var Stages = []string{StageBlock, StageTransactions, StageNodes, StageApps, StageAccounts}
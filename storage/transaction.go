@@ -0,0 +1,229 @@
+package storage
+
+import (
+	"database/sql"
+	"encoding/json"
+	"math/big"
+	"strings"
+	"time"
+
+	"github.com/lib/pq"
+	"github.com/pokt-foundation/pocket-go/provider"
+	indexerlib "github.com/pokt-foundation/pocket-indexer-lib"
+	postgresdriver "github.com/pokt-foundation/pocket-indexer-lib/postgres-driver"
+)
+
+const (
+	// chainsSeparator separates the blockchains of a transaction, saved joined in a single column
+	chainsSeparator = ","
+
+	selectTransactionBlockFromTime   = "EXISTS (SELECT 1 FROM blocks b WHERE b.height = transactions.height AND b.time >= ?)"
+	selectTransactionBlockToTime     = "EXISTS (SELECT 1 FROM blocks b WHERE b.height = transactions.height AND b.time <= ?)"
+	selectTransactionsByHashesScript = "SELECT * FROM transactions WHERE hash = ANY($1)"
+)
+
+// dbTransaction struct handler for a row of the transactions table saved by the indexer
+type dbTransaction struct {
+	ID              int            `db:"id"`
+	Hash            string         `db:"hash"`
+	FromAddress     sql.NullString `db:"from_address"`
+	ToAddress       sql.NullString `db:"to_address"`
+	AppPubKey       string         `db:"app_pub_key"`
+	Blockchains     string         `db:"blockchains"`
+	MessageType     string         `db:"message_type"`
+	Height          int            `db:"height"`
+	Index           int            `db:"index"`
+	StdTx           jsonbStdTx     `db:"stdtx"`
+	TxResult        jsonbTxResult  `db:"tx_result"`
+	Tx              string         `db:"tx"`
+	Entropy         int            `db:"entropy"`
+	Fee             int            `db:"fee"`
+	FeeDenomination string         `db:"fee_denomination"`
+	Amount          string         `db:"amount"`
+}
+
+func (t *dbTransaction) toIndexerTransaction() *indexerlib.Transaction {
+	amount, _ := new(big.Int).SetString(t.Amount, 10)
+
+	return &indexerlib.Transaction{
+		Hash:            t.Hash,
+		FromAddress:     t.FromAddress.String,
+		ToAddress:       t.ToAddress.String,
+		AppPubKey:       t.AppPubKey,
+		Blockchains:     strings.Split(t.Blockchains, chainsSeparator),
+		MessageType:     t.MessageType,
+		Height:          t.Height,
+		Index:           t.Index,
+		StdTx:           t.StdTx.StdTx,
+		TxResult:        t.TxResult.TxResult,
+		Tx:              t.Tx,
+		Entropy:         t.Entropy,
+		Fee:             t.Fee,
+		FeeDenomination: t.FeeDenomination,
+		Amount:          amount,
+	}
+}
+
+// jsonbStdTx struct handler for the stdtx column, decoded from JSONB
+type jsonbStdTx struct {
+	*provider.StdTx
+}
+
+// Scan decodes the JSONB of the column
+func (s *jsonbStdTx) Scan(value any) error {
+	return scanJSONB(value, &s.StdTx)
+}
+
+// jsonbTxResult struct handler for the tx_result column, decoded from JSONB
+type jsonbTxResult struct {
+	*provider.TxResult
+}
+
+// Scan decodes the JSONB of the column
+func (r *jsonbTxResult) Scan(value any) error {
+	return scanJSONB(value, &r.TxResult)
+}
+
+func scanJSONB(value any, dest any) error {
+	if value == nil {
+		return nil
+	}
+
+	b, ok := value.([]byte)
+	if !ok {
+		return postgresdriver.ErrByteTypeAssertionFailed
+	}
+
+	return json.Unmarshal(b, dest)
+}
+
+// Transaction directions of TransactionFilter, the transactions the address sent or received
+const (
+	DirectionSent     = "sent"
+	DirectionReceived = "received"
+)
+
+// TransactionFilter struct handler for the filters of the transactions read, the zero values don't filter
+// Address keeps the transactions it sent or received, only the ones of Direction when it is set
+// Blockchains keeps the transactions of all of them and Success the ones with a result code of 0, or the others when false
+// FromTime and ToTime compare the time of the block of the transactions and the amounts are decimal strings
+type TransactionFilter struct {
+	Address     string
+	Direction   string
+	Height      int
+	MessageType string
+	Blockchains []string
+	FromHeight  int
+	ToHeight    int
+	FromTime    time.Time
+	ToTime      time.Time
+	Success     *bool
+	MinFee      *int
+	MaxFee      *int
+	MinAmount   string
+	MaxAmount   string
+}
+
+// filterCondition is a condition of a filter, applied only when it is set
+type filterCondition struct {
+	set       bool
+	condition string
+	args      []interface{}
+}
+
+func (f *TransactionFilter) apply(query *pageQuery) {
+	for _, condition := range f.getConditions() {
+		if condition.set {
+			query.where(condition.condition, condition.args...)
+		}
+	}
+}
+
+func (f *TransactionFilter) getConditions() []filterCondition {
+	return []filterCondition{
+		f.getAddressCondition(),
+		{set: f.Height > 0, condition: "height = ?", args: []interface{}{f.Height}},
+		{set: f.MessageType != "", condition: "message_type = ?", args: []interface{}{f.MessageType}},
+		{set: len(f.Blockchains) > 0, condition: "string_to_array(blockchains, ',') @> ?::text[]", args: []interface{}{pq.Array(f.Blockchains)}},
+		{set: f.FromHeight > 0, condition: "height >= ?", args: []interface{}{f.FromHeight}},
+		{set: f.ToHeight > 0, condition: "height <= ?", args: []interface{}{f.ToHeight}},
+		{set: !f.FromTime.IsZero(), condition: selectTransactionBlockFromTime, args: []interface{}{f.FromTime}},
+		{set: !f.ToTime.IsZero(), condition: selectTransactionBlockToTime, args: []interface{}{f.ToTime}},
+		{set: f.Success != nil, condition: "((tx_result->>'code')::int = 0) = ?", args: []interface{}{f.Success}},
+		{set: f.MinFee != nil, condition: "fee >= ?", args: []interface{}{f.MinFee}},
+		{set: f.MaxFee != nil, condition: "fee <= ?", args: []interface{}{f.MaxFee}},
+		{set: f.MinAmount != "", condition: "amount >= ?::numeric", args: []interface{}{f.MinAmount}},
+		{set: f.MaxAmount != "", condition: "amount <= ?::numeric", args: []interface{}{f.MaxAmount}},
+	}
+}
+
+func (f *TransactionFilter) getAddressCondition() filterCondition {
+	switch f.Direction {
+	case DirectionSent:
+		return filterCondition{set: f.Address != "", condition: "from_address = ?", args: []interface{}{f.Address}}
+	case DirectionReceived:
+		return filterCondition{set: f.Address != "", condition: "to_address = ?", args: []interface{}{f.Address}}
+	default:
+		return filterCondition{set: f.Address != "", condition: "(from_address = ? OR to_address = ?)", args: []interface{}{f.Address, f.Address}}
+	}
+}
+
+// ReadTransactionsPage returns a page of the transactions kept by the filter by height and index
+// and whether there are more past it
+func (d *PostgresDriver) ReadTransactionsPage(filter *TransactionFilter, options *PageOptions) ([]*indexerlib.Transaction, bool, error) {
+	query := &pageQuery{table: "transactions", key: []string{"height", "index"}, cursorKey: heightIndexCursorKey}
+	filter.apply(query)
+
+	var dbTransactions []*dbTransaction
+
+	script, args := query.build(options)
+
+	err := d.Select(&dbTransactions, d.Rebind(script), args...)
+	if err != nil {
+		return nil, false, err
+	}
+
+	indexes, hasMore := getPageIndexes(len(dbTransactions), options)
+	transactions := make([]*indexerlib.Transaction, 0, len(indexes))
+
+	for _, i := range indexes {
+		transactions = append(transactions, dbTransactions[i].toIndexerTransaction())
+	}
+
+	return transactions, hasMore, nil
+}
+
+// CountTransactions returns the quantity of transactions kept by the filter
+func (d *PostgresDriver) CountTransactions(filter *TransactionFilter) (int64, error) {
+	query := &pageQuery{table: "transactions"}
+	filter.apply(query)
+
+	var quantity int64
+
+	script, args := query.count()
+
+	err := d.Get(&quantity, d.Rebind(script), args...)
+	if err != nil {
+		return 0, err
+	}
+
+	return quantity, nil
+}
+
+// ReadTransactionsByHashes returns the transactions saved of the hashes, in no particular order
+func (d *PostgresDriver) ReadTransactionsByHashes(hashes []string) ([]*indexerlib.Transaction, error) {
+	var dbTransactions []*dbTransaction
+
+	err := d.Select(&dbTransactions, selectTransactionsByHashesScript, pq.Array(hashes))
+	if err != nil {
+		return nil, err
+	}
+
+	transactions := make([]*indexerlib.Transaction, 0, len(dbTransactions))
+
+	for _, dbTransaction := range dbTransactions {
+		transactions = append(transactions, dbTransaction.toIndexerTransaction())
+	}
+
+	return transactions, nil
+}